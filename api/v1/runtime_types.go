@@ -49,6 +49,10 @@ const (
 	LabelKymaManagedBy       = "operator.kyma-project.io/managed-by"
 	LabelKymaInternal        = "operator.kyma-project.io/internal"
 	LabelKymaPlatformRegion  = "kyma-project.io/platform-region"
+
+	// LabelReconcilerManagedBy marks the resources the infrastructure manager creates in the runtime
+	LabelReconcilerManagedBy        = "reconciler.kyma-project.io/managed-by"
+	LabelValueInfrastructureManager = "infrastructure-manager"
 )
 
 const (
@@ -56,6 +60,7 @@ const (
 	RuntimeStateFailed      = "Failed"
 	RuntimeStatePending     = "Pending"
 	RuntimeStateTerminating = "Terminating"
+	RuntimeStateHibernated  = "Hibernated"
 )
 
 type RuntimeConditionType string
@@ -66,6 +71,10 @@ const (
 	ConditionTypeOidcConfigured         RuntimeConditionType = "OidcConfigured"
 	ConditionTypeRuntimeConfigured      RuntimeConditionType = "Configured"
 	ConditionTypeRuntimeDeprovisioned   RuntimeConditionType = "Deprovisioned"
+	ConditionTypeRuntimeBootstrapped    RuntimeConditionType = "Bootstrapped"
+	ConditionTypeDeletionBlocked        RuntimeConditionType = "DeletionBlocked"
+	ConditionTypeUpgradeInProgress      RuntimeConditionType = "UpgradeInProgress"
+	ConditionTypeHibernated             RuntimeConditionType = "Hibernated"
 )
 
 type RuntimeConditionReason string
@@ -96,6 +105,28 @@ const (
 	ConditionReasonOidcConfigured           = RuntimeConditionReason("OidcConfigured")
 	ConditionReasonOidcError                = RuntimeConditionReason("OidcConfigurationErr")
 	ConditionReasonSeedNotFound             = RuntimeConditionReason("SeedNotFound")
+	ConditionReasonCloudProfileError        = RuntimeConditionReason("CloudProfileErr")
+
+	ConditionReasonKubernetesUpgradeStep    = RuntimeConditionReason("KubernetesUpgradeStep")
+	ConditionReasonKubernetesUpgradePending = RuntimeConditionReason("KubernetesUpgradePending")
+	ConditionReasonKubernetesUpgradeError   = RuntimeConditionReason("KubernetesUpgradeErr")
+
+	ConditionReasonHibernated = RuntimeConditionReason("Hibernated")
+	ConditionReasonWakingUp   = RuntimeConditionReason("WakingUp")
+
+	ConditionReasonBootstrapCompleted = RuntimeConditionReason("BootstrapCompleted")
+	ConditionReasonBootstrapError     = RuntimeConditionReason("BootstrapErr")
+
+	ConditionReasonPreDeleteHooksPending   = RuntimeConditionReason("PreDeleteHooksPending")
+	ConditionReasonPreDeleteHooksCompleted = RuntimeConditionReason("PreDeleteHooksCompleted")
+	ConditionReasonPreDeleteHooksSkipped   = RuntimeConditionReason("PreDeleteHooksSkipped")
+	ConditionReasonPreDeleteHookError      = RuntimeConditionReason("PreDeleteHookErr")
+	ConditionReasonPreDeleteHooksTimeout   = RuntimeConditionReason("PreDeleteHooksTimeout")
+	ConditionReasonDeletionProtected       = RuntimeConditionReason("DeletionProtected")
+	ConditionReasonShootReleased           = RuntimeConditionReason("ShootReleased")
+
+	ConditionReasonShootAdopted  = RuntimeConditionReason("ShootAdopted")
+	ConditionReasonAdoptionError = RuntimeConditionReason("AdoptionErr")
 )
 
 //+kubebuilder:object:root=true
@@ -133,11 +164,45 @@ type RuntimeSpec struct {
 type RuntimeStatus struct {
 	// State signifies current state of Runtime
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=Pending;Ready;Terminating;Failed
+	// +kubebuilder:validation:Enum=Pending;Ready;Terminating;Failed;Hibernated
 	State State `json:"state,omitempty"`
 
 	// List of status conditions to indicate the status of a ServiceInstance.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// VersionLifecycle describes the CloudProfile lifecycle of the Kubernetes and machine image versions used by the shoot
+	VersionLifecycle *VersionLifecycle `json:"versionLifecycle,omitempty"`
+}
+
+type VersionClassification string
+
+const (
+	VersionClassificationPreview    VersionClassification = "preview"
+	VersionClassificationSupported  VersionClassification = "supported"
+	VersionClassificationDeprecated VersionClassification = "deprecated"
+	VersionClassificationExpired    VersionClassification = "expired"
+	VersionClassificationUnknown    VersionClassification = "unknown"
+)
+
+type VersionLifecycle struct {
+	Kubernetes    VersionStatus               `json:"kubernetes"`
+	MachineImages []MachineImageVersionStatus `json:"machineImages,omitempty"`
+}
+
+type VersionStatus struct {
+	Version string `json:"version"`
+	// Classification of the version in the CloudProfile, unknown if the version is not listed or not classified
+	// +kubebuilder:validation:Enum=preview;supported;deprecated;expired;unknown
+	Classification VersionClassification `json:"classification"`
+	// ExpirationDate after which Gardener forces the update of the version
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty"`
+	// ExpiresSoon is set if the version expires within the configured warning period
+	ExpiresSoon bool `json:"expiresSoon,omitempty"`
+}
+
+type MachineImageVersionStatus struct {
+	Name          string `json:"name"`
+	VersionStatus `json:",inline"`
 }
 
 type RuntimeShoot struct {
@@ -152,37 +217,193 @@ type RuntimeShoot struct {
 	Provider            Provider               `json:"provider"`
 	Networking          Networking             `json:"networking"`
 	ControlPlane        *gardener.ControlPlane `json:"controlPlane,omitempty"`
+	Maintenance         *Maintenance           `json:"maintenance,omitempty"`
+	Hibernation         *gardener.Hibernation  `json:"hibernation,omitempty"`
+}
+
+type Maintenance struct {
+	// TimeWindow in which the shoot is maintained, a window in the night of the timezone is used if not set
+	TimeWindow *MaintenanceTimeWindow `json:"timeWindow,omitempty"`
+	// Timezone of the time window in the IANA format, for example Europe/Berlin, the timezone of the region is used if not set
+	Timezone *string `json:"timezone,omitempty"`
+	// AutoUpdate overrides the auto-update settings of the infrastructure manager configuration
+	AutoUpdate *MaintenanceAutoUpdate `json:"autoUpdate,omitempty"`
+}
+
+type MaintenanceTimeWindow struct {
+	// Begin of the time window in the HHMMSS format
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3])[0-5][0-9][0-5][0-9]$`
+	Begin string `json:"begin"`
+	// End of the time window in the HHMMSS format
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3])[0-5][0-9][0-5][0-9]$`
+	End string `json:"end"`
+}
+
+type MaintenanceAutoUpdate struct {
+	KubernetesVersion   *bool `json:"kubernetesVersion,omitempty"`
+	MachineImageVersion *bool `json:"machineImageVersion,omitempty"`
 }
 
 type Kubernetes struct {
 	Version       *string   `json:"version,omitempty"`
 	KubeAPIServer APIServer `json:"kubeAPIServer,omitempty"`
+	// ClusterAutoscaler settings, the settings not set are taken from the defaults configured for the plan
+	ClusterAutoscaler *ClusterAutoscaler `json:"clusterAutoscaler,omitempty"`
+	// VerticalPodAutoscaler settings, the settings not set are taken from the defaults configured for the plan
+	VerticalPodAutoscaler *VerticalPodAutoscaler `json:"verticalPodAutoscaler,omitempty"`
+	// KubeProxy settings, the settings not set are taken from the defaults configured for the plan
+	KubeProxy *KubeProxy `json:"kubeProxy,omitempty"`
+}
+
+type ClusterAutoscaler struct {
+	// Expander selects the node group to scale up, for example least-waste, most-pods, priority or random
+	Expander *gardener.ExpanderMode `json:"expander,omitempty"`
+	// ScaleDownDelayAfterAdd defines how long after scale up the scale down evaluation resumes
+	ScaleDownDelayAfterAdd *metav1.Duration `json:"scaleDownDelayAfterAdd,omitempty"`
+	// ScaleDownDelayAfterDelete defines how long after node deletion the scale down evaluation resumes
+	ScaleDownDelayAfterDelete *metav1.Duration `json:"scaleDownDelayAfterDelete,omitempty"`
+	// ScaleDownDelayAfterFailure defines how long after scale down failure the scale down evaluation resumes
+	ScaleDownDelayAfterFailure *metav1.Duration `json:"scaleDownDelayAfterFailure,omitempty"`
+	// ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty"`
+	// ScaleDownUtilizationThreshold is the ratio of the requested resources to the capacity of the node under which the node can be scaled down
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	ScaleDownUtilizationThreshold *float64 `json:"scaleDownUtilizationThreshold,omitempty"`
+	// MaxNodeProvisionTime defines how long the cluster autoscaler waits for the node to be provisioned
+	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty"`
+}
+
+type VerticalPodAutoscaler struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type KubeProxy struct {
+	// +kubebuilder:validation:Enum=IPTables;IPVS
+	Mode *gardener.ProxyMode `json:"mode,omitempty"`
 }
 
 type APIServer struct {
 	OidcConfig           gardener.OIDCConfig    `json:"oidcConfig,omitempty"`
 	AdditionalOidcConfig *[]gardener.OIDCConfig `json:"additionalOidcConfig,omitempty"`
+	// AdmissionPlugins contains the admission plugins of the API server and their configuration
+	AdmissionPlugins []gardener.AdmissionPlugin `json:"admissionPlugins,omitempty"`
+	// Requests contains the limits of the requests in flight handled by the API server
+	Requests *gardener.APIServerRequests `json:"requests,omitempty"`
+	// EventTTL controls the amount of time to retain events
+	EventTTL *metav1.Duration `json:"eventTTL,omitempty"`
+	// EnableAnonymousAuthentication allows the anonymous requests to the API server, it is defaulted by Gardener if not set
+	EnableAnonymousAuthentication *bool `json:"enableAnonymousAuthentication,omitempty"`
 }
 
 type Provider struct {
-	//+kubebuilder:validation:Enum=aws;azure;gcp;openstack
+	// Type must match one of the hyperscaler providers registered in the converter
 	Type                 string                `json:"type"`
 	Workers              []gardener.Worker     `json:"workers"`
 	AdditionalWorkers    *[]gardener.Worker    `json:"additionalWorkers,omitempty"`
 	ControlPlaneConfig   *runtime.RawExtension `json:"controlPlaneConfig,omitempty"`
 	InfrastructureConfig *runtime.RawExtension `json:"infrastructureConfig,omitempty"`
+	OpenStack            *OpenStackProvider    `json:"openstack,omitempty"`
+	AWS                  *AWSProvider          `json:"aws,omitempty"`
+	Azure                *AzureProvider        `json:"azure,omitempty"`
+	GCP                  *GCPProvider          `json:"gcp,omitempty"`
+}
+
+// OpenStackProvider overrides the OpenStack settings from the converter configuration
+type OpenStackProvider struct {
+	FloatingPoolName     string `json:"floatingPoolName,omitempty"`
+	LoadBalancerProvider string `json:"loadBalancerProvider,omitempty"`
+	ExposureClassName    string `json:"exposureClassName,omitempty"`
+}
+
+// AWSProvider contains the AWS specific settings of the Runtime
+type AWSProvider struct {
+	// VPC references an existing VPC the shoot is created in, a new VPC is created from the nodes CIDR if not set
+	VPC *AWSVPC `json:"vpc,omitempty"`
+}
+
+// AWSVPC references an existing AWS VPC, the zone subnets are carved from the nodes CIDR
+type AWSVPC struct {
+	// ID of the existing VPC, for example vpc-0123456789abcdef0
+	ID string `json:"id"`
+	// CIDR of the existing VPC, if set the nodes CIDR must be inside it
+	CIDR string `json:"cidr,omitempty"`
+}
+
+// AzureProvider contains the Azure specific settings of the Runtime
+type AzureProvider struct {
+	// VNet references an existing VNet the shoot is created in, a new VNet is created from the nodes CIDR if not set
+	VNet *AzureVNet `json:"vnet,omitempty"`
+}
+
+// AzureVNet references an existing Azure VNet, the zone subnets are carved from the nodes CIDR
+type AzureVNet struct {
+	// Name of the existing VNet
+	Name string `json:"name"`
+	// ResourceGroup the existing VNet belongs to
+	ResourceGroup string `json:"resourceGroup"`
+	// CIDR of the existing VNet, if set the nodes CIDR must be inside it
+	CIDR string `json:"cidr,omitempty"`
+}
+
+// GCPProvider contains the GCP specific settings of the Runtime
+type GCPProvider struct {
+	// CloudNAT configures the Cloud NAT of the shoot network
+	CloudNAT *GCPCloudNAT `json:"cloudNAT,omitempty"`
+	// Internal is the CIDR of the subnet created for internal load balancers, it must not overlap with the Runtime networking CIDRs
+	Internal string `json:"internal,omitempty"`
+	// Worker contains the settings applied to all workers of the Runtime
+	Worker *GCPWorker `json:"worker,omitempty"`
+}
+
+// GCPCloudNAT contains the Cloud NAT settings of the shoot network
+type GCPCloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM in the Cloud NAT gateway
+	MinPortsPerVM *int32 `json:"minPortsPerVM,omitempty"`
+	// EndpointIndependentMapping enables the endpoint independent mapping of the Cloud NAT gateway
+	EndpointIndependentMapping bool `json:"endpointIndependentMapping,omitempty"`
+}
+
+// GCPWorker contains the GCP settings of the worker machines
+type GCPWorker struct {
+	// ServiceAccount is attached to the worker machines
+	ServiceAccount *GCPServiceAccount `json:"serviceAccount,omitempty"`
+	// LocalSSDInterface is the interface of the SCRATCH data volumes, either NVME or SCSI
+	LocalSSDInterface string `json:"localSSDInterface,omitempty"`
+}
+
+// GCPServiceAccount is a GCP service account attached to the worker machines
+type GCPServiceAccount struct {
+	// Email of the service account
+	Email string `json:"email"`
+	// Scopes granted to the service account
+	Scopes []string `json:"scopes"`
 }
 
 type Networking struct {
-	Type     *string `json:"type,omitempty"`
-	Pods     string  `json:"pods"`
-	Nodes    string  `json:"nodes"`
-	Services string  `json:"services"`
+	Type *string `json:"type,omitempty"`
+	// Pods, Nodes and Services are the CIDRs of the primary IP family, IPv6 CIDRs are used for IPv6 single-stack shoots
+	Pods     string `json:"pods"`
+	Nodes    string `json:"nodes"`
+	Services string `json:"services"`
+	// IPFamilies of the shoot networking, the first one is the primary family. IPv4 single-stack is used if not set.
+	// Dual-stack shoots get the ranges of the secondary family from the hyperscaler.
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:Enum=IPv4;IPv6
+	IPFamilies []gardener.IPFamily `json:"ipFamilies,omitempty"`
 }
 
 type Security struct {
 	Administrators []string           `json:"administrators"`
 	Networking     NetworkingSecurity `json:"networking"`
+	// APIServerACL restricts the access to the API server of the shoot
+	APIServerACL *APIServerACL `json:"apiServerACL,omitempty"`
+}
+
+type APIServerACL struct {
+	// AllowedCIDRs from which the API server is reachable, the egress CIDRs of KCP are always allowed
+	// +kubebuilder:validation:MinItems=1
+	AllowedCIDRs []string `json:"allowedCIDRs"`
 }
 
 type NetworkingSecurity struct {
@@ -218,6 +439,18 @@ func (k *Runtime) UpdateStateReady(c RuntimeConditionType, r RuntimeConditionRea
 	meta.SetStatusCondition(&k.Status.Conditions, condition)
 }
 
+func (k *Runtime) UpdateStateHibernated(msg string) {
+	k.Status.State = RuntimeStateHibernated
+	condition := metav1.Condition{
+		Type:               string(ConditionTypeHibernated),
+		Status:             "True",
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(ConditionReasonHibernated),
+		Message:            msg,
+	}
+	meta.SetStatusCondition(&k.Status.Conditions, condition)
+}
+
 func (k *Runtime) UpdateStateDeletion(c RuntimeConditionType, r RuntimeConditionReason, status, msg string) {
 	if status != "False" {
 		k.Status.State = RuntimeStateTerminating
@@ -252,6 +485,22 @@ func (k *Runtime) UpdateStatePending(c RuntimeConditionType, r RuntimeConditionR
 	meta.SetStatusCondition(&k.Status.Conditions, condition)
 }
 
+// UpdateCondition sets the condition without changing the state of the Runtime
+func (k *Runtime) UpdateCondition(c RuntimeConditionType, r RuntimeConditionReason, status, msg string) {
+	condition := metav1.Condition{
+		Type:               string(c),
+		Status:             metav1.ConditionStatus(status),
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(r),
+		Message:            msg,
+	}
+	meta.SetStatusCondition(&k.Status.Conditions, condition)
+}
+
+func (k *Runtime) RemoveCondition(c RuntimeConditionType) {
+	meta.RemoveStatusCondition(&k.Status.Conditions, string(c))
+}
+
 func (k *Runtime) IsStateWithConditionSet(runtimeState State, c RuntimeConditionType, r RuntimeConditionReason) bool {
 	if k.Status.State != runtimeState {
 		return false
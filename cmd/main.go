@@ -35,10 +35,15 @@ import (
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
 	runtime_controller "github.com/kyma-project/infrastructure-manager/internal/controller/runtime"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm"
+	"github.com/kyma-project/infrastructure-manager/pkg/bootstrap"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/cloudprofile"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/kubeconfig"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/predelete"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -85,6 +90,8 @@ const (
 	defaultShootReconcileRequeueDuration = 30 * time.Second
 	defaultRuntimeCtrlWorkersCnt         = 25
 	defaultGardenerClusterCtrlWorkersCnt = 25
+	defaultPreDeleteHooksTimeout         = 30 * time.Minute
+	defaultCloudProfileCacheTTL          = 10 * time.Minute
 )
 
 func main() {
@@ -103,6 +110,13 @@ func main() {
 	var gardenerClusterCtrlWorkersCnt int
 	var converterConfigFilepath string
 	var auditLogMandatory bool
+	var bootstrapManifestsPath string
+	var bootstrapManifestsPrune bool
+	var preDeleteHookNames string
+	var preDeleteWebhookURL string
+	var preDeleteHooksTimeout time.Duration
+	var cloudProfileCacheTTL time.Duration
+	var versionExpirationWarningPeriod time.Duration
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -121,6 +135,13 @@ func main() {
 	flag.IntVar(&gardenerClusterCtrlWorkersCnt, "gardener-cluster-ctrl-workers-cnt", defaultGardenerClusterCtrlWorkersCnt, "A number of workers running in parallel for Gardener Cluster Controller")
 	flag.StringVar(&converterConfigFilepath, "converter-config-filepath", "/converter-config/converter_config.json", "A file path to the gardener shoot converter configuration.")
 	flag.BoolVar(&auditLogMandatory, "audit-log-mandatory", true, "Feature flag to enable strict mode for audit log configuration")
+	flag.StringVar(&bootstrapManifestsPath, "bootstrap-manifests-path", "", "A directory with manifests applied to every runtime after provisioning. Bootstrap is disabled when empty.")
+	flag.BoolVar(&bootstrapManifestsPrune, "bootstrap-manifests-prune", true, "Delete bootstrap objects from the runtime when they are removed from the manifests")
+	flag.StringVar(&preDeleteHookNames, "pre-delete-hooks", "", "A comma separated list of hooks run before the shoot is deleted (backup, loadbalancer-cleanup, webhook)")
+	flag.StringVar(&preDeleteWebhookURL, "pre-delete-webhook-url", "", "URL called by the webhook pre-delete hook")
+	flag.DurationVar(&preDeleteHooksTimeout, "pre-delete-hooks-timeout", defaultPreDeleteHooksTimeout, "Time after which the deletion is blocked if the pre-delete hooks are not completed")
+	flag.DurationVar(&cloudProfileCacheTTL, "cloud-profile-cache-ttl", defaultCloudProfileCacheTTL, "Time for which the Gardener cloud profiles are cached")
+	flag.DurationVar(&versionExpirationWarningPeriod, "version-expiration-warning-period", 0, "Time before the expiration of the Kubernetes and machine image versions in which the warning events are emitted for the runtimes. Warnings are disabled when 0.")
 
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
@@ -203,24 +224,63 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = config.ConverterConfig.CloudProfile.Validate(hyperscaler.Types()); err != nil {
+		setupLog.Error(err, "invalid cloud profile configuration")
+		os.Exit(1)
+	}
+
+	if err = config.ConverterConfig.Provider.ValidateWorkerConfigTemplates(hyperscaler.Types()); err != nil {
+		setupLog.Error(err, "invalid worker config templates")
+		os.Exit(1)
+	}
+
+	if err = config.ConverterConfig.ValidateExtensions(extensions.BuiltInTypes()); err != nil {
+		setupLog.Error(err, "invalid extensions configuration")
+		os.Exit(1)
+	}
+
 	auditLogDataMap, err := loadAuditLogDataMap(config.ConverterConfig.AuditLog.TenantConfigPath)
 	if err != nil {
 		setupLog.Error(err, "invalid audit log tenant configuration")
 		os.Exit(1)
 	}
 
+	var bootstrapManifests bootstrap.Manifests
+	if bootstrapManifestsPath != "" {
+		bootstrapManifests, err = bootstrap.LoadFromDir(bootstrapManifestsPath)
+		if err != nil {
+			setupLog.Error(err, "unable to load bootstrap manifests")
+			os.Exit(1)
+		}
+	}
+
+	preDeleteHooks, err := predelete.NewHooks(preDeleteHookNames, predelete.Config{
+		KcpClient:  mgr.GetClient(),
+		WebhookURL: preDeleteWebhookURL,
+	})
+	if err != nil {
+		setupLog.Error(err, "invalid pre-delete hooks configuration")
+		os.Exit(1)
+	}
+
 	cfg := fsm.RCCfg{
-		GardenerRequeueDuration:       defaultGardenerRequeueDuration,
-		RequeueDurationShootCreate:    defaultShootCreateRequeueDuration,
-		RequeueDurationShootDelete:    defaultShootDeleteRequeueDuration,
-		RequeueDurationShootReconcile: defaultShootReconcileRequeueDuration,
-		ControlPlaneRequeueDuration:   defaultControlPlaneRequeueDuration,
-		Finalizer:                     infrastructuremanagerv1.Finalizer,
-		ShootNamesapace:               gardenerNamespace,
-		Config:                        config,
-		AuditLogMandatory:             auditLogMandatory,
-		Metrics:                       metrics,
-		AuditLogging:                  auditLogDataMap,
+		GardenerRequeueDuration:        defaultGardenerRequeueDuration,
+		RequeueDurationShootCreate:     defaultShootCreateRequeueDuration,
+		RequeueDurationShootDelete:     defaultShootDeleteRequeueDuration,
+		RequeueDurationShootReconcile:  defaultShootReconcileRequeueDuration,
+		ControlPlaneRequeueDuration:    defaultControlPlaneRequeueDuration,
+		Finalizer:                      infrastructuremanagerv1.Finalizer,
+		ShootNamesapace:                gardenerNamespace,
+		Config:                         config,
+		AuditLogMandatory:              auditLogMandatory,
+		Metrics:                        metrics,
+		AuditLogging:                   auditLogDataMap,
+		BootstrapManifests:             bootstrapManifests,
+		BootstrapPrune:                 bootstrapManifestsPrune,
+		PreDeleteHooks:                 preDeleteHooks,
+		PreDeleteHooksTimeout:          preDeleteHooksTimeout,
+		CloudProfiles:                  cloudprofile.NewCache(gardenerClient, cloudProfileCacheTTL),
+		VersionExpirationWarningPeriod: versionExpirationWarningPeriod,
 	}
 
 	runtimeReconciler := runtime_controller.NewRuntimeReconciler(
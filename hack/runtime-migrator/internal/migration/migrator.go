@@ -6,9 +6,9 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/hack/runtime-migrator-app/internal/initialisation"
-	shootutil "github.com/kyma-project/infrastructure-manager/hack/runtime-migrator-app/internal/shoot"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/kubeconfig"
+	gardener_shoot "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot"
 	"github.com/pkg/errors"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,10 +20,7 @@ import (
 	"slices"
 )
 
-const (
-	migratorLabel                      = "operator.kyma-project.io/created-by-migrator"
-	ShootNetworkingFilterExtensionType = "shoot-networking-filter"
-)
+const migratorLabel = "operator.kyma-project.io/created-by-migrator"
 
 type Migrator struct {
 	cfg                initialisation.Config
@@ -47,73 +44,31 @@ func (m Migrator) Do(ctx context.Context, shoot v1beta1.Shoot) (v1.Runtime, erro
 		return v1.Runtime{}, err
 	}
 
-	var oidcConfig = getOidcConfig(shoot)
-	var licenceType = shoot.Annotations["kcp.provisioner.kyma-project.io/licence-type"]
 	labels, err := getAllRuntimeLabels(ctx, shoot, m.kcpClient)
 	if err != nil {
 		return v1.Runtime{}, err
 	}
-	var isShootNetworkFilteringEnabled = checkIfShootNetworkFilteringEnabled(shoot)
 
-	var runtime = v1.Runtime{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "Runtime",
-			APIVersion: "infrastructuremanager.kyma-project.io/v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      labels["kyma-project.io/runtime-id"],
-			Namespace: "kcp-system",
-			Labels:    labels,
-		},
-		Spec: v1.RuntimeSpec{
-			Shoot: v1.RuntimeShoot{
-				Name:              shoot.Name,
-				Purpose:           *shoot.Spec.Purpose,
-				Region:            shoot.Spec.Region,
-				LicenceType:       &licenceType,
-				SecretBindingName: *shoot.Spec.SecretBindingName,
-				Kubernetes: v1.Kubernetes{
-					Version: &shoot.Spec.Kubernetes.Version,
-					KubeAPIServer: v1.APIServer{
-						OidcConfig:           oidcConfig,
-						AdditionalOidcConfig: &[]v1beta1.OIDCConfig{oidcConfig},
-					},
-				},
-				Provider: v1.Provider{
-					Type:                 shoot.Spec.Provider.Type,
-					Workers:              shootutil.FilterOutFields(shoot.Spec.Provider.Workers),
-					ControlPlaneConfig:   shoot.Spec.Provider.ControlPlaneConfig,
-					InfrastructureConfig: shoot.Spec.Provider.InfrastructureConfig,
-				},
-				Networking: v1.Networking{
-					Type:     shoot.Spec.Networking.Type,
-					Pods:     *shoot.Spec.Networking.Pods,
-					Nodes:    *shoot.Spec.Networking.Nodes,
-					Services: *shoot.Spec.Networking.Services,
-				},
-			},
-			Security: v1.Security{
-				Administrators: subjects,
-				Networking: v1.NetworkingSecurity{
-					Filter: v1.Filter{
-						Ingress: &v1.Ingress{
-							// deliberately left empty for now, as it was a feature implemented in the Provisioner
-						},
-						Egress: v1.Egress{
-							Enabled: isShootNetworkFilteringEnabled,
-						},
-					},
-				},
-			},
-		},
-	}
+	return runtimeFromShoot(shoot, labels, subjects), nil
+}
 
-	controlPlane := getControlPlane(shoot)
-	if controlPlane != nil {
-		runtime.Spec.Shoot.ControlPlane = controlPlane
+// runtimeFromShoot creates the Runtime CR with the shared Shoot to Runtime converter, see README-rm.md for the content of the Runtime CR
+func runtimeFromShoot(shoot v1beta1.Shoot, labels map[string]string, administrators []string) v1.Runtime {
+	var runtime = gardener_shoot.ToRuntime(shoot)
+	runtime.TypeMeta = metav1.TypeMeta{
+		Kind:       "Runtime",
+		APIVersion: "infrastructuremanager.kyma-project.io/v1",
 	}
+	runtime.ObjectMeta = metav1.ObjectMeta{
+		Name:      labels["kyma-project.io/runtime-id"],
+		Namespace: "kcp-system",
+		Labels:    labels,
+	}
+	runtime.Spec.Security.Administrators = administrators
+	// deliberately left empty for now, as it was a feature implemented in the Provisioner
+	runtime.Spec.Security.Networking.Filter.Ingress = &v1.Ingress{}
 
-	return runtime, nil
+	return runtime
 }
 
 func processAdministrators(ctx context.Context, provider kubeconfig.Provider, shootName string, isDryRun bool) ([]string, error) {
@@ -197,23 +152,6 @@ func labelsCRBsAsDeprecated(ctx context.Context, clientset *kubernetes.Clientset
 	return nil
 }
 
-func getOidcConfig(shoot v1beta1.Shoot) v1beta1.OIDCConfig {
-	var oidcConfig = v1beta1.OIDCConfig{
-		CABundle:             nil, // deliberately left empty
-		ClientAuthentication: shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientAuthentication,
-		ClientID:             shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientID,
-		GroupsClaim:          shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.GroupsClaim,
-		GroupsPrefix:         shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.GroupsPrefix,
-		IssuerURL:            shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.IssuerURL,
-		RequiredClaims:       shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.RequiredClaims,
-		SigningAlgs:          shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.SigningAlgs,
-		UsernameClaim:        shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.UsernameClaim,
-		UsernamePrefix:       shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.UsernamePrefix,
-	}
-
-	return oidcConfig
-}
-
 func getAllRuntimeLabels(ctx context.Context, shoot v1beta1.Shoot, kcpClient client.Client) (map[string]string, error) {
 	enrichedRuntimeLabels := map[string]string{}
 	var err error
@@ -247,30 +185,3 @@ func getAllRuntimeLabels(ctx context.Context, shoot v1beta1.Shoot, kcpClient cli
 
 	return enrichedRuntimeLabels, err
 }
-
-func getControlPlane(shoot v1beta1.Shoot) *v1beta1.ControlPlane {
-	if shoot.Spec.ControlPlane != nil {
-		if shoot.Spec.ControlPlane.HighAvailability != nil {
-			return &v1beta1.ControlPlane{HighAvailability: &v1beta1.HighAvailability{
-				FailureTolerance: v1beta1.FailureTolerance{
-					Type: shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type,
-				},
-			},
-			}
-		}
-	}
-
-	return nil
-}
-
-func checkIfShootNetworkFilteringEnabled(shoot v1beta1.Shoot) bool {
-	for _, extension := range shoot.Spec.Extensions {
-		if extension.Type == ShootNetworkingFilterExtensionType {
-			if extension.Disabled == nil {
-				return true
-			}
-			return !(*extension.Disabled)
-		}
-	}
-	return false
-}
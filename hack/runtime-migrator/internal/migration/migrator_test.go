@@ -4,7 +4,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"testing"
 
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestFilterOnlySupportedTypesOfCRBs(t *testing.T) {
@@ -206,3 +209,51 @@ func TestGetAdministratorsList(t *testing.T) {
 		})
 	}
 }
+
+func TestRuntimeFromShoot(t *testing.T) {
+	labels := map[string]string{"kyma-project.io/runtime-id": "runtime-id"}
+	workers := []v1beta1.Worker{
+		{Name: "cpu-worker-0", Machine: v1beta1.Machine{Type: "m6i.large"}, Minimum: 3, Maximum: 20, Zones: []string{"eu-central-1a"}},
+		{Name: "additional", Machine: v1beta1.Machine{Type: "m6i.xlarge"}, Minimum: 0, Maximum: 1, Zones: []string{"eu-central-1a"}},
+	}
+
+	t.Run("should take the first worker as the main one and the others as additional workers", func(t *testing.T) {
+		// given
+		shoot := v1beta1.Shoot{Spec: v1beta1.ShootSpec{Provider: v1beta1.Provider{Type: "aws", Workers: workers}}}
+
+		// when
+		runtime := runtimeFromShoot(shoot, labels, []string{"admin@example.com"})
+
+		// then
+		require.Equal(t, "runtime-id", runtime.Name)
+		require.Equal(t, "kcp-system", runtime.Namespace)
+		require.Equal(t, workers[:1], runtime.Spec.Shoot.Provider.Workers)
+		require.Equal(t, ptr.To(workers[1:]), runtime.Spec.Shoot.Provider.AdditionalWorkers)
+		require.Equal(t, []string{"admin@example.com"}, runtime.Spec.Security.Administrators)
+		require.Equal(t, &v1.Ingress{}, runtime.Spec.Security.Networking.Filter.Ingress)
+	})
+
+	t.Run("should not set the licence type if the shoot has no licence type annotation", func(t *testing.T) {
+		// given
+		shoot := v1beta1.Shoot{Spec: v1beta1.ShootSpec{Provider: v1beta1.Provider{Type: "aws", Workers: workers[:1]}}}
+
+		// when
+		runtime := runtimeFromShoot(shoot, labels, nil)
+
+		// then
+		require.Nil(t, runtime.Spec.Shoot.LicenceType)
+		require.Nil(t, runtime.Spec.Shoot.Provider.AdditionalWorkers)
+	})
+
+	t.Run("should take the licence type set by the Provisioner", func(t *testing.T) {
+		// given
+		shoot := v1beta1.Shoot{Spec: v1beta1.ShootSpec{Provider: v1beta1.Provider{Type: "aws", Workers: workers[:1]}}}
+		shoot.Annotations = map[string]string{"kcp.provisioner.kyma-project.io/licence-type": "TestDevelopmentAndDemo"}
+
+		// when
+		runtime := runtimeFromShoot(shoot, labels, nil)
+
+		// then
+		require.Equal(t, ptr.To("TestDevelopmentAndDemo"), runtime.Spec.Shoot.LicenceType)
+	})
+}
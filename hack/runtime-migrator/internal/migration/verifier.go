@@ -1,8 +1,6 @@
 package migration
 
 import (
-	"slices"
-
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/hack/shoot-comparator/pkg/shoot"
@@ -66,48 +64,26 @@ func (v Verifier) newConverter(shootToMatch v1beta1.Shoot) (gardener_shoot.Conve
 		return gardener_shoot.Converter{}, err
 	}
 
-	imgName, imgVersion := getImageNameAndVersion(shootToMatch.Spec.Provider.Workers)
-
 	return gardener_shoot.NewConverterPatch(gardener_shoot.PatchOpts{
-		ConverterConfig:   v.converterConfig,
-		AuditLogData:      auditLogData,
-		Zones:             getZones(shootToMatch.Spec.Provider.Workers),
-		ShootK8SVersion:   shootToMatch.Spec.Kubernetes.Version,
-		ShootImageName:    imgName,
-		ShootImageVersion: imgVersion,
-		Extensions:        shootToMatch.Spec.Extensions,
-		Resources:         shootToMatch.Spec.Resources,
+		ConverterConfig:       v.converterConfig,
+		AuditLogData:          auditLogData,
+		ShootK8SVersion:       shootToMatch.Spec.Kubernetes.Version,
+		Workers:               shootToMatch.Spec.Provider.Workers,
+		Extensions:            shootToMatch.Spec.Extensions,
+		Resources:             shootToMatch.Spec.Resources,
+		InfrastructureConfig:  shootToMatch.Spec.Provider.InfrastructureConfig,
+		ControlPlaneConfig:    shootToMatch.Spec.Provider.ControlPlaneConfig,
+		CloudProfileName:      shootToMatch.Spec.CloudProfileName,
+		ExposureClassName:     shootToMatch.Spec.ExposureClassName,
+		MaintenanceTimeWindow: maintenanceTimeWindowOf(shootToMatch),
 	}), nil
 }
 
-func getImageNameAndVersion(workers []v1beta1.Worker) (string, string) {
-	var imageName, imageVersion string
-
-	for _, worker := range workers {
-		if worker.Machine.Image != nil {
-			imageName = worker.Machine.Image.Name
-			if worker.Machine.Image.Version != nil {
-				imageVersion = *worker.Machine.Image.Version
-			}
-			break
-		}
-	}
-
-	return imageName, imageVersion
-}
-
-func getZones(workers []v1beta1.Worker) []string {
-	var zones []string
-
-	for _, worker := range workers {
-		for _, zone := range worker.Zones {
-			if !slices.Contains(zones, zone) {
-				zones = append(zones, zone)
-			}
-		}
+func maintenanceTimeWindowOf(shoot v1beta1.Shoot) *v1beta1.MaintenanceTimeWindow {
+	if shoot.Spec.Maintenance == nil {
+		return nil
 	}
-
-	return zones
+	return shoot.Spec.Maintenance.TimeWindow
 }
 
 func compare(originalShoot, convertedShoot v1beta1.Shoot) (*Difference, error) {
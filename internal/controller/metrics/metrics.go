@@ -27,6 +27,11 @@ const (
 	reason                         = "reason"
 	message                        = "message"
 	KubeconfigExpirationMetricName = "im_kubeconfig_expiration"
+	VersionExpirationMetricName    = "im_runtime_version_expiration"
+	component                      = "component"
+	version                        = "version"
+	classification                 = "classification"
+	kubernetesComponent            = "kubernetes"
 	expires                        = "expires"
 	lastSyncAnnotation             = "operator.kyma-project.io/last-sync"
 )
@@ -47,6 +52,7 @@ type metricsImpl struct {
 	gardenerClustersStateGaugeVec *prometheus.GaugeVec
 	kubeconfigExpirationGauge     *prometheus.GaugeVec
 	runtimeStateGauge             *prometheus.GaugeVec
+	versionExpirationGauge        *prometheus.GaugeVec
 	runtimeFSMUnexpectedStopsCnt  prometheus.Counter
 }
 
@@ -70,13 +76,19 @@ func NewMetrics() Metrics {
 				Name:      RuntimeStateMetricName,
 				Help:      "Exposes current Status.state for Runtime CRs",
 			}, []string{runtimeIDKeyName, runtimeNameKeyName, shootNameIDKeyName, provider, state, message}),
+		versionExpirationGauge: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Subsystem: componentName,
+				Name:      VersionExpirationMetricName,
+				Help:      "Exposes the CloudProfile expiration date of the Kubernetes and machine image versions used by Runtime CRs in epoch timestamp value format, 0 if the version does not expire",
+			}, []string{runtimeIDKeyName, runtimeNameKeyName, shootNameIDKeyName, component, version, classification}),
 		runtimeFSMUnexpectedStopsCnt: prometheus.NewCounter(
 			prometheus.CounterOpts{
 				Name: RuntimeFSMStopMetricName,
 				Help: "Exposes the number of unexpected state machine stop events",
 			}),
 	}
-	ctrlMetrics.Registry.MustRegister(m.gardenerClustersStateGaugeVec, m.kubeconfigExpirationGauge, m.runtimeStateGauge, m.runtimeFSMUnexpectedStopsCnt)
+	ctrlMetrics.Registry.MustRegister(m.gardenerClustersStateGaugeVec, m.kubeconfigExpirationGauge, m.runtimeStateGauge, m.versionExpirationGauge, m.runtimeFSMUnexpectedStopsCnt)
 	return m
 }
 
@@ -93,18 +105,42 @@ func (m metricsImpl) SetRuntimeStates(runtime v1.Runtime) {
 
 		m.CleanUpRuntimeGauge(runtimeID, runtime.Name)
 		m.runtimeStateGauge.WithLabelValues(runtimeID, runtime.Name, runtime.Spec.Shoot.Name, runtime.Spec.Shoot.Provider.Type, string(runtime.Status.State), reason).Set(1)
+		m.setVersionExpiration(runtimeID, runtime)
+	}
+}
+
+func (m metricsImpl) setVersionExpiration(runtimeID string, runtime v1.Runtime) {
+	lifecycle := runtime.Status.VersionLifecycle
+	if lifecycle == nil {
+		return
+	}
+
+	set := func(name string, status v1.VersionStatus) {
+		var expirationTimeEpoch int64
+		if status.ExpirationDate != nil {
+			expirationTimeEpoch = status.ExpirationDate.Unix()
+		}
+		m.versionExpirationGauge.WithLabelValues(runtimeID, runtime.Name, runtime.Spec.Shoot.Name, name, status.Version, string(status.Classification)).Set(float64(expirationTimeEpoch))
+	}
+
+	set(kubernetesComponent, lifecycle.Kubernetes)
+	for _, image := range lifecycle.MachineImages {
+		set(image.Name, image.VersionStatus)
 	}
 }
 
 func (m metricsImpl) CleanUpRuntimeGauge(runtimeID, runtimeName string) {
-	m.runtimeStateGauge.DeletePartialMatch(prometheus.Labels{
+	labels := prometheus.Labels{
 		runtimeIDKeyName:   runtimeID,
 		runtimeNameKeyName: runtimeName,
-	})
+	}
+	m.runtimeStateGauge.DeletePartialMatch(labels)
+	m.versionExpirationGauge.DeletePartialMatch(labels)
 }
 
 func (m metricsImpl) ResetRuntimeMetrics() {
 	m.runtimeStateGauge.Reset()
+	m.versionExpirationGauge.Reset()
 }
 
 func (m metricsImpl) IncRuntimeFSMStopCounter() {
@@ -0,0 +1,91 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/cloudprofile"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+const versionExpiringEventReason = "VersionExpiring"
+
+func (m *fsm) cloudProfileGetter(ctx context.Context) cloudprofile.Getter {
+	if m.CloudProfiles != nil {
+		return m.CloudProfiles.Getter(ctx)
+	}
+	return cloudprofile.NewGetter(ctx, m.ShootClient)
+}
+
+// validateWithCloudProfile checks the converted shoot against its CloudProfile, the existingShoot is nil for new shoots.
+// The error is returned only if the CloudProfile cannot be read.
+func validateWithCloudProfile(getCloudProfile cloudprofile.Getter, shoot, existingShoot *gardener.Shoot) (field.ErrorList, error) {
+	cloudProfileName := ptr.Deref(shoot.Spec.CloudProfileName, "")
+	if cloudProfileName == "" {
+		return nil, nil
+	}
+
+	cloudProfile, err := getCloudProfile(cloudProfileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudprofile.ValidateShoot(cloudProfile, shoot, existingShoot, time.Now()), nil
+}
+
+// updateVersionLifecycle sets the CloudProfile lifecycle of the versions used by the shoot in the Runtime status.
+// A warning event is emitted for every version that starts to expire soon, the status is kept if the CloudProfile cannot be read.
+func (m *fsm) updateVersionLifecycle(ctx context.Context, s *systemState) {
+	cloudProfileName := ptr.Deref(s.shoot.Spec.CloudProfileName, "")
+	if cloudProfileName == "" {
+		return
+	}
+
+	cloudProfile, err := m.cloudProfileGetter(ctx)(cloudProfileName)
+	if err != nil {
+		m.log.Error(err, "Failed to compute the version lifecycle", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return
+	}
+
+	lifecycle := cloudprofile.VersionLifecycle(cloudProfile, s.shoot, time.Now(), m.RCCfg.VersionExpirationWarningPeriod)
+	s.instance.Status.VersionLifecycle = lifecycle
+
+	var previous imv1.VersionLifecycle
+	if s.snapshot.VersionLifecycle != nil {
+		previous = *s.snapshot.VersionLifecycle
+	}
+
+	if expiresSoon(lifecycle.Kubernetes, previous.Kubernetes) {
+		m.emitVersionExpiringEvent(s, "Kubernetes version", lifecycle.Kubernetes)
+	}
+
+	for _, image := range lifecycle.MachineImages {
+		var previousImage imv1.VersionStatus
+		for _, p := range previous.MachineImages {
+			if p.Name == image.Name && p.Version == image.Version {
+				previousImage = p.VersionStatus
+			}
+		}
+
+		if expiresSoon(image.VersionStatus, previousImage) {
+			m.emitVersionExpiringEvent(s, fmt.Sprintf("Machine image %s version", image.Name), image.VersionStatus)
+		}
+	}
+}
+
+func (m *fsm) emitVersionExpiringEvent(s *systemState, subject string, status imv1.VersionStatus) {
+	m.Event(
+		&s.instance,
+		"Warning",
+		versionExpiringEventReason,
+		fmt.Sprintf("%s %s expires on %s: %s/%s", subject, status.Version, status.ExpirationDate.UTC().Format(time.DateOnly), s.instance.Namespace, s.instance.Name),
+	)
+}
+
+func expiresSoon(status, previous imv1.VersionStatus) bool {
+	return status.ExpiresSoon && (!previous.ExpiresSoon || previous.Version != status.Version)
+}
@@ -0,0 +1,103 @@
+package fsm
+
+import (
+	"fmt"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/cloudprofile"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// handleKubernetesUpgrade sets the Kubernetes version of the converted shoot to the next step of the upgrade to the Runtime version.
+// The UpgradeInProgress condition describes the current step and is removed when the shoot reaches the Runtime version.
+// A non nil state function is returned if the shoot must not be patched.
+func handleKubernetesUpgrade(m *fsm, s *systemState, getCloudProfile cloudprofile.Getter, shoot *gardener.Shoot) (stateFn, *ctrl.Result, error) {
+	currentVersion := s.shoot.Spec.Kubernetes.Version
+	targetVersion := shoot.Spec.Kubernetes.Version
+	cloudProfileName := ptr.Deref(shoot.Spec.CloudProfileName, "")
+
+	if currentVersion == "" || currentVersion == targetVersion || cloudProfileName == "" {
+		s.instance.RemoveCondition(imv1.ConditionTypeUpgradeInProgress)
+		return nil, nil, nil
+	}
+
+	cloudProfile, err := getCloudProfile(cloudProfileName)
+	if err != nil {
+		m.log.Error(err, "Failed to read the cloud profile, scheduling for retry")
+		s.instance.UpdateStatePending(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonGardenerError, "False", fmt.Sprintf("Gardener API cloud profile error: %v", err))
+		return updateStatusAndRequeueAfter(m.RCCfg.GardenerRequeueDuration)
+	}
+
+	now := time.Now()
+	nextVersion, err := cloudprofile.NextKubernetesVersion(cloudProfile, currentVersion, targetVersion, now)
+	if err != nil {
+		m.log.Error(err, "Failed to plan the Kubernetes upgrade, exiting with no retry")
+		m.Metrics.IncRuntimeFSMStopCounter()
+		s.instance.RemoveCondition(imv1.ConditionTypeUpgradeInProgress)
+		return updateStatePendingWithErrorAndStop(&s.instance, imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonKubernetesUpgradeError, fmt.Sprintf("Kubernetes upgrade error: %v", err))
+	}
+
+	if nextVersion == targetVersion && !isUpgradeInProgress(&s.instance) {
+		return nil, nil, nil
+	}
+
+	if m.ConverterConfig.Kubernetes.UpgradeInMaintenanceWindow {
+		window, err := maintenanceTimeWindow(s.shoot)
+		if err != nil {
+			m.log.Error(err, "Failed to parse the maintenance time window, upgrading without waiting", "shoot", s.shoot.Name)
+		}
+
+		if window != nil && !window.Contains(now) {
+			m.log.Info("Kubernetes upgrade is waiting for the maintenance time window", "shoot", s.shoot.Name, "window", window.String())
+			s.instance.UpdateCondition(
+				imv1.ConditionTypeUpgradeInProgress,
+				imv1.ConditionReasonKubernetesUpgradePending,
+				"True",
+				fmt.Sprintf("Kubernetes upgrade from %s to %s waits for the maintenance time window %s, target version is %s", currentVersion, nextVersion, window.String(), targetVersion))
+			return updateStatusAndRequeueAfter(window.RandomDurationUntilNext(now, false))
+		}
+	}
+
+	m.log.Info("Upgrading Kubernetes version", "shoot", s.shoot.Name, "from", currentVersion, "to", nextVersion, "target", targetVersion)
+	shoot.Spec.Kubernetes.Version = nextVersion
+	s.instance.UpdateCondition(
+		imv1.ConditionTypeUpgradeInProgress,
+		imv1.ConditionReasonKubernetesUpgradeStep,
+		"True",
+		fmt.Sprintf("Upgrading Kubernetes from %s to %s, target version is %s", currentVersion, nextVersion, targetVersion))
+
+	return nil, nil, nil
+}
+
+func isUpgradeInProgress(runtime *imv1.Runtime) bool {
+	return meta.IsStatusConditionTrue(runtime.Status.Conditions, string(imv1.ConditionTypeUpgradeInProgress))
+}
+
+// isShootReconciled returns true if Gardener completed the reconciliation of the latest shoot specification
+func isShootReconciled(shoot *gardener.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil && lastOperation.State == gardener.LastOperationStateSucceeded &&
+		shoot.Status.ObservedGeneration >= shoot.Generation
+}
+
+func maintenanceTimeWindow(shoot *gardener.Shoot) (*timewindow.MaintenanceTimeWindow, error) {
+	timeWindow := maintenanceTimeWindowOf(shoot)
+	if timeWindow == nil {
+		return nil, nil
+	}
+
+	return timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End)
+}
+
+func maintenanceTimeWindowOf(shoot *gardener.Shoot) *gardener.MaintenanceTimeWindow {
+	if shoot.Spec.Maintenance == nil {
+		return nil
+	}
+
+	return shoot.Spec.Maintenance.TimeWindow
+}
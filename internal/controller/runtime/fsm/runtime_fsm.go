@@ -10,8 +10,11 @@ import (
 	"github.com/go-logr/logr"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
+	"github.com/kyma-project/infrastructure-manager/pkg/bootstrap"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/cloudprofile"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"github.com/kyma-project/infrastructure-manager/pkg/predelete"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,6 +37,14 @@ type RCCfg struct {
 	AuditLogMandatory             bool
 	Metrics                       metrics.Metrics
 	AuditLogging                  auditlogs.Configuration
+	BootstrapManifests            bootstrap.Manifests
+	BootstrapPrune                bool
+	PreDeleteHooks                predelete.Hooks
+	PreDeleteHooksTimeout         time.Duration
+	// CloudProfiles caches the Gardener CloudProfiles, they are read in every reconciliation if not set
+	CloudProfiles *cloudprofile.Cache
+	// VersionExpirationWarningPeriod before the expiration of the used versions in which the warning events are emitted, disabled if 0
+	VersionExpirationWarningPeriod time.Duration
 	config.Config
 }
 
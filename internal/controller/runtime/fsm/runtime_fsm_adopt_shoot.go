@@ -0,0 +1,137 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	gardener_shoot "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// sFnAdoptShoot populates the Runtime CR from the existing shoot, the shoot is managed by KIM afterwards
+func sFnAdoptShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	m.log.Info("Adopt shoot state")
+
+	// the shoot must never be created for a Runtime CR marked for adoption
+	if s.shoot == nil {
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonAdoptionError,
+			fmt.Sprintf("Shoot %s to adopt not found", s.instance.Spec.Shoot.Name))
+	}
+
+	if !s.shoot.GetDeletionTimestamp().IsZero() {
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonAdoptionError,
+			fmt.Sprintf("Shoot %s to adopt is being deleted", s.shoot.Name))
+	}
+
+	// the shoot managed by another Runtime CR must never be taken over
+	if runtimeID, found := s.shoot.Annotations[extender.ShootRuntimeIDAnnotation]; found && runtimeID != s.instance.Name {
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonAdoptionError,
+			fmt.Sprintf("Shoot %s to adopt belongs to the runtime %s", s.shoot.Name, runtimeID))
+	}
+
+	adopted := adoptedRuntime(s.instance, *s.shoot, m.ConverterConfig.APIServerACL.KCPEgressCIDRs)
+
+	data, err := m.AuditLogging.GetAuditLogData(adopted.Spec.Shoot.Provider.Type, adopted.Spec.Shoot.Region)
+	if err != nil {
+		m.log.Error(err, msgFailedToConfigureAuditlogs)
+	}
+
+	// the shoot converted from the adopted Runtime must not differ from the existing one, otherwise the first patch would change the cluster
+	convertedShoot, err := convertPatch(&adopted, gardener_shoot.PatchOpts{
+		ConverterConfig:       m.ConverterConfig,
+		AuditLogData:          data,
+		Workers:               s.shoot.Spec.Provider.Workers,
+		ShootK8SVersion:       s.shoot.Spec.Kubernetes.Version,
+		Extensions:            s.shoot.Spec.Extensions,
+		Resources:             s.shoot.Spec.Resources,
+		InfrastructureConfig:  s.shoot.Spec.Provider.InfrastructureConfig,
+		ControlPlaneConfig:    s.shoot.Spec.Provider.ControlPlaneConfig,
+		CloudProfileName:      s.shoot.Spec.CloudProfileName,
+		ExposureClassName:     s.shoot.Spec.ExposureClassName,
+		MaintenanceTimeWindow: maintenanceTimeWindowOf(s.shoot),
+	})
+	if err == nil {
+		err = gardener_shoot.Verify(*s.shoot, convertedShoot)
+	}
+
+	if err != nil {
+		m.log.Error(err, "Shoot cannot be adopted, exiting with no retry", "Name", s.shoot.Name)
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonAdoptionError,
+			fmt.Sprintf("Shoot adoption failed: %v", err))
+	}
+
+	delete(adopted.Annotations, reconciler.AdoptShootAnnotation)
+	if err := m.Update(ctx, &adopted); err != nil {
+		m.log.Error(err, "Failed to update Runtime CR with the adopted shoot")
+		return updateStatusAndStopWithError(err)
+	}
+
+	m.log.Info("Shoot adopted", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+	s.instance = adopted
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonShootAdopted,
+		"Unknown",
+		"Shoot adopted",
+	)
+
+	return updateStatusAndRequeue()
+}
+
+// fields which cannot be read from the shoot are kept from the Runtime CR
+func adoptedRuntime(instance imv1.Runtime, shoot gardener.Shoot, kcpEgressCIDRs []string) imv1.Runtime {
+	adopted := instance.DeepCopy()
+	fromShoot := gardener_shoot.ToRuntime(shoot)
+
+	runtimeShoot := fromShoot.Spec.Shoot
+	runtimeShoot.PlatformRegion = instance.Spec.Shoot.PlatformRegion
+	runtimeShoot.EnforceSeedLocation = instance.Spec.Shoot.EnforceSeedLocation
+	if runtimeShoot.LicenceType == nil {
+		runtimeShoot.LicenceType = instance.Spec.Shoot.LicenceType
+	}
+
+	adopted.Spec.Shoot = runtimeShoot
+	adopted.Spec.Security.Networking = fromShoot.Spec.Security.Networking
+	adopted.Spec.Security.APIServerACL = withoutKCPEgressCIDRs(fromShoot.Spec.Security.APIServerACL, kcpEgressCIDRs)
+
+	return *adopted
+}
+
+// the KCP egress CIDRs are added to the ACL by the converter, they are kept only if the ACL has no other CIDRs
+func withoutKCPEgressCIDRs(apiServerACL *imv1.APIServerACL, kcpEgressCIDRs []string) *imv1.APIServerACL {
+	if apiServerACL == nil {
+		return nil
+	}
+
+	allowedCIDRs := slices.DeleteFunc(slices.Clone(apiServerACL.AllowedCIDRs), func(cidr string) bool {
+		return slices.Contains(kcpEgressCIDRs, cidr)
+	})
+	if len(allowedCIDRs) == 0 {
+		return apiServerACL
+	}
+
+	return &imv1.APIServerACL{
+		AllowedCIDRs: allowedCIDRs,
+	}
+}
@@ -0,0 +1,195 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	gardener_shoot "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestAdoptShootState(t *testing.T) {
+	t.Run("Should stop with error when shoot to adopt does not exist", func(t *testing.T) {
+		// given
+		runtimeStub := runtimeForAdoption()
+		m := &mocks.Metrics{}
+		m.On("IncRuntimeFSMStopCounter").Return()
+		testFsm := &fsm{RCCfg: RCCfg{Metrics: m}}
+		systemState := &systemState{instance: runtimeStub}
+
+		// when
+		stateFn, _, err := sFnAdoptShoot(context.Background(), testFsm, systemState)
+
+		// then
+		require.NoError(t, err)
+		require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+		assertAdoptionError(t, systemState.instance, "Shoot test-shoot to adopt not found")
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Should stop with error when shoot to adopt is being deleted", func(t *testing.T) {
+		// given
+		shoot := shootForTest()
+		now := metav1.Now()
+		shoot.DeletionTimestamp = &now
+		m := &mocks.Metrics{}
+		m.On("IncRuntimeFSMStopCounter").Return()
+		testFsm := &fsm{RCCfg: RCCfg{Metrics: m}}
+		systemState := &systemState{instance: runtimeForAdoption(), shoot: shoot}
+
+		// when
+		stateFn, _, err := sFnAdoptShoot(context.Background(), testFsm, systemState)
+
+		// then
+		require.NoError(t, err)
+		require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+		assertAdoptionError(t, systemState.instance, "Shoot test-shoot to adopt is being deleted")
+	})
+
+	t.Run("Should stop with error when shoot to adopt belongs to another runtime", func(t *testing.T) {
+		// given
+		shoot := shootForTest()
+		shoot.Annotations = map[string]string{extender.ShootRuntimeIDAnnotation: "other-runtime"}
+		m := &mocks.Metrics{}
+		m.On("IncRuntimeFSMStopCounter").Return()
+		testFsm := &fsm{RCCfg: RCCfg{Metrics: m}}
+		systemState := &systemState{instance: runtimeForAdoption(), shoot: shoot}
+
+		// when
+		stateFn, _, err := sFnAdoptShoot(context.Background(), testFsm, systemState)
+
+		// then
+		require.NoError(t, err)
+		require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+		assertAdoptionError(t, systemState.instance, "Shoot test-shoot to adopt belongs to the runtime other-runtime")
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Should stop with error when shoot cannot be converted back", func(t *testing.T) {
+		// given
+		runtimeStub := *makeInputRuntimeWithAnnotation(map[string]string{reconciler.AdoptShootAnnotation: "true"})
+		runtimeStub.Labels[imv1.LabelKymaRuntimeID] = runtimeStub.Name
+
+		shoot, err := convertPatch(&runtimeStub, gardener_shoot.PatchOpts{})
+		require.NoError(t, err)
+		// the Runtime CR can only allow the CIDRs, so the ACL denying them cannot be converted back
+		shoot.Spec.Extensions = append(shoot.Spec.Extensions, gardener.Extension{
+			Type:           extensions.ACLExtensionType,
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"rule":{"action":"DENY","type":"remote_ip","cidrs":["10.0.0.0/8"]}}`)},
+		})
+
+		m := &mocks.Metrics{}
+		m.On("IncRuntimeFSMStopCounter").Return()
+		testFsm := &fsm{RCCfg: RCCfg{
+			Metrics: m,
+			Config: config.Config{
+				ConverterConfig: config.ConverterConfig{
+					APIServerACL: config.APIServerACLConfig{KCPEgressCIDRs: []string{"192.168.0.0/24"}},
+				},
+			},
+		}}
+		systemState := &systemState{instance: runtimeStub, shoot: &shoot}
+
+		// when
+		stateFn, _, err := sFnAdoptShoot(context.Background(), testFsm, systemState)
+
+		// then
+		require.NoError(t, err)
+		require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+		condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+		require.NotNil(t, condition)
+		assert.Equal(t, string(imv1.ConditionReasonAdoptionError), condition.Reason)
+		assert.Equal(t, `Shoot adoption failed: converted shoot differs from the original: spec/extensions/acl: expected {"action":"DENY","type":"remote_ip","cidrs":["10.0.0.0/8"]}, got {"action":"ALLOW","type":"remote_ip","cidrs":["10.0.0.0/8","192.168.0.0/24"]}`, condition.Message)
+		m.AssertExpectations(t)
+	})
+}
+
+func TestAdoptedRuntime(t *testing.T) {
+	// given
+	runtimeStub := runtimeForAdoption()
+	runtimeStub.Spec.Shoot.PlatformRegion = "cf-eu10"
+	runtimeStub.Spec.Shoot.LicenceType = ptr.To("Partner")
+	runtimeStub.Spec.Security.Administrators = []string{"admin@example.com"}
+
+	shoot := shootForTest()
+	shoot.Spec.Kubernetes.Version = "1.30"
+
+	// when
+	adopted := adoptedRuntime(runtimeStub, *shoot, nil)
+
+	// then
+	assert.Equal(t, "cf-eu10", adopted.Spec.Shoot.PlatformRegion)
+	assert.Equal(t, ptr.To("Partner"), adopted.Spec.Shoot.LicenceType)
+	assert.Equal(t, ptr.To("1.30"), adopted.Spec.Shoot.Kubernetes.Version)
+	assert.Equal(t, []string{"admin@example.com"}, adopted.Spec.Security.Administrators)
+	assert.Equal(t, runtimeStub.Labels, adopted.Labels)
+	assert.Equal(t, "true", adopted.Annotations[reconciler.AdoptShootAnnotation])
+}
+
+func TestAdoptedRuntimeAPIServerACL(t *testing.T) {
+	kcpEgressCIDRs := []string{"192.168.0.0/24"}
+
+	for _, testCase := range []struct {
+		name        string
+		shootCIDRs  []string
+		expectedACL *imv1.APIServerACL
+	}{
+		{
+			name:        "Should remove the KCP egress CIDRs",
+			shootCIDRs:  []string{"10.0.0.0/8", "192.168.0.0/24"},
+			expectedACL: &imv1.APIServerACL{AllowedCIDRs: []string{"10.0.0.0/8"}},
+		},
+		{
+			name:        "Should keep the KCP egress CIDRs when no other CIDR is allowed",
+			shootCIDRs:  []string{"192.168.0.0/24"},
+			expectedACL: &imv1.APIServerACL{AllowedCIDRs: []string{"192.168.0.0/24"}},
+		},
+		{
+			name: "Should not set the ACL when the shoot has none",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			shoot := shootForTest()
+			if testCase.shootCIDRs != nil {
+				acl, err := extensions.NewACLExtension(imv1.APIServerACL{AllowedCIDRs: testCase.shootCIDRs}, kcpEgressCIDRs)
+				require.NoError(t, err)
+				shoot.Spec.Extensions = []gardener.Extension{*acl}
+			}
+
+			// when
+			adopted := adoptedRuntime(runtimeForAdoption(), *shoot, kcpEgressCIDRs)
+
+			// then
+			assert.Equal(t, testCase.expectedACL, adopted.Spec.Security.APIServerACL)
+		})
+	}
+}
+
+func runtimeForAdoption() imv1.Runtime {
+	runtimeStub := runtimeForTest()
+	runtimeStub.Labels = map[string]string{imv1.LabelKymaRuntimeID: "runtime-id"}
+	runtimeStub.Annotations = map[string]string{reconciler.AdoptShootAnnotation: "true"}
+	return runtimeStub
+}
+
+func assertAdoptionError(t *testing.T, runtime imv1.Runtime, message string) {
+	condition := meta.FindStatusCondition(runtime.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+	require.NotNil(t, condition)
+	assert.Equal(t, string(imv1.ConditionReasonAdoptionError), condition.Reason)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, message, condition.Message)
+	assert.Equal(t, imv1.State(imv1.RuntimeStateFailed), runtime.Status.State)
+}
@@ -0,0 +1,61 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/bootstrap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func sFnApplyBootstrapManifests(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	m.log.Info("Apply bootstrap manifests state")
+
+	objs, err := m.BootstrapManifests.Render(s.instance)
+	if err != nil {
+		// rendering errors are caused by the configuration, retrying will not help
+		m.log.Error(err, "Failed to render bootstrap manifests")
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeBootstrapped,
+			imv1.ConditionReasonBootstrapError,
+			fmt.Sprintf("failed to render bootstrap manifests: %v", err))
+	}
+
+	shootAdminClient, err := GetShootClient(ctx, m.Client, s.instance)
+	if err != nil {
+		updateBootstrapFailed(&s.instance, err)
+		return updateStatusAndStopWithError(err)
+	}
+
+	result, err := bootstrap.NewApplier(shootAdminClient, m.BootstrapPrune).Apply(ctx, objs)
+	if err != nil {
+		updateBootstrapFailed(&s.instance, err)
+		m.log.Error(err, "Cannot apply bootstrap manifests on shoot, scheduling for retry")
+		return updateStatusAndRequeueAfter(m.RCCfg.ControlPlaneRequeueDuration)
+	}
+
+	if len(result.Pruned) > 0 {
+		m.log.Info("Following bootstrap objects were pruned", "prunedObjects", result.Pruned)
+	}
+
+	s.instance.UpdateStateReady(
+		imv1.ConditionTypeRuntimeBootstrapped,
+		imv1.ConditionReasonBootstrapCompleted,
+		fmt.Sprintf("Bootstrap manifests applied (%d objects)", len(result.Applied)),
+	)
+
+	return updateStatusAndStop()
+}
+
+func updateBootstrapFailed(rt *imv1.Runtime, err error) {
+	rt.UpdateStatePending(
+		imv1.ConditionTypeRuntimeBootstrapped,
+		imv1.ConditionReasonBootstrapError,
+		string(metav1.ConditionFalse),
+		fmt.Sprintf("failed to apply bootstrap manifests: %v", err),
+	)
+}
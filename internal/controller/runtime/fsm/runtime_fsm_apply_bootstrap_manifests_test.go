@@ -0,0 +1,125 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	"github.com/kyma-project/infrastructure-manager/pkg/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const bootstrapManifestForTest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: runtime-info
+  namespace: kube-system
+data:
+  shootName: {{ .ShootName }}
+`
+
+func TestApplyBootstrapManifestsState(t *testing.T) {
+	t.Run("Should apply bootstrap manifests and set Bootstrapped condition", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fakeClient := newBootstrapFakeClient()
+		GetShootClient = func(_ context.Context, _ client.Client, _ imv1.Runtime) (client.Client, error) {
+			return fakeClient, nil
+		}
+
+		manifest, err := bootstrap.Parse("runtime-info.yaml", bootstrapManifestForTest)
+		require.NoError(t, err)
+
+		testFsm := &fsm{RCCfg: RCCfg{BootstrapManifests: bootstrap.Manifests{manifest}}}
+		systemState := &systemState{instance: runtimeForTest()}
+
+		expectedRuntimeConditions := []metav1.Condition{
+			{
+				Type:    string(imv1.ConditionTypeRuntimeBootstrapped),
+				Reason:  string(imv1.ConditionReasonBootstrapCompleted),
+				Status:  "True",
+				Message: "Bootstrap manifests applied (1 objects)",
+			},
+		}
+
+		// when
+		stateFn, _, _ := sFnApplyBootstrapManifests(ctx, testFsm, systemState)
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+
+		var cm corev1.ConfigMap
+		err = fakeClient.Get(ctx, types.NamespacedName{Name: "runtime-info", Namespace: "kube-system"}, &cm)
+		require.NoError(t, err)
+		assert.Equal(t, "test-shoot", cm.Data["shootName"])
+		assert.Equal(t, "true", cm.Labels[bootstrap.BootstrapLabel])
+
+		assertEqualConditions(t, expectedRuntimeConditions, systemState.instance.Status.Conditions)
+	})
+
+	t.Run("Should stop with failed state when manifest cannot be rendered", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		manifest, err := bootstrap.Parse("broken.yaml", "name: {{ .Unknown }}")
+		require.NoError(t, err)
+
+		m := &mocks.Metrics{}
+		m.On("IncRuntimeFSMStopCounter").Return()
+
+		testFsm := &fsm{
+			RCCfg: RCCfg{
+				BootstrapManifests: bootstrap.Manifests{manifest},
+				Metrics:            m,
+			},
+		}
+		systemState := &systemState{instance: runtimeForTest()}
+
+		// when
+		stateFn, _, _ := sFnApplyBootstrapManifests(ctx, testFsm, systemState)
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+		assert.Equal(t, imv1.State(imv1.RuntimeStateFailed), systemState.instance.Status.State)
+
+		condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeBootstrapped))
+		require.NotNil(t, condition)
+		assert.Equal(t, string(imv1.ConditionReasonBootstrapError), condition.Reason)
+		m.AssertCalled(t, "IncRuntimeFSMStopCounter")
+	})
+}
+
+// fake client does not support server-side apply, it is simulated with create or update
+func newBootstrapFakeClient() client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				existing := &unstructured.Unstructured{}
+				existing.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+				err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+				if k8serrors.IsNotFound(err) {
+					return c.Create(ctx, obj)
+				}
+				if err != nil {
+					return err
+				}
+				obj.SetResourceVersion(existing.GetResourceVersion())
+				return c.Update(ctx, obj)
+			},
+		}).Build()
+}
@@ -19,7 +19,7 @@ import (
 var (
 	//nolint:gochecknoglobals
 	labelsManagedByKIM = map[string]string{
-		"reconciler.kyma-project.io/managed-by": "infrastructure-manager",
+		imv1.LabelReconcilerManagedBy: imv1.LabelValueInfrastructureManager,
 	}
 )
 
@@ -58,6 +58,10 @@ func sFnApplyClusterRoleBindings(ctx context.Context, m *fsm, s *systemState) (s
 		"Cluster admin configuration complete",
 	)
 
+	if len(m.BootstrapManifests) > 0 {
+		return switchState(sFnApplyBootstrapManifests)
+	}
+
 	return updateStatusAndStop()
 }
 
@@ -213,7 +217,7 @@ func toAdminClusterRoleBindingNoLabels(name string) rbacv1.ClusterRoleBinding {
 }
 
 func toAdminClusterRoleBinding(name string) rbacv1.ClusterRoleBinding {
-	return toAdminClusterRoleBindingWithLabel(name, "reconciler.kyma-project.io/managed-by", "infrastructure-manager")
+	return toAdminClusterRoleBindingWithLabel(name, imv1.LabelReconcilerManagedBy, imv1.LabelValueInfrastructureManager)
 }
 
 //nolint:gochecknoglobals
@@ -69,7 +69,29 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 			&s.instance,
 			imv1.ConditionTypeRuntimeProvisioned,
 			imv1.ConditionReasonConversionError,
-			"Runtime conversion error")
+			fmt.Sprintf("Runtime conversion error: %v", err))
+	}
+
+	cloudProfileErrs, err := validateWithCloudProfile(m.cloudProfileGetter(ctx), &shoot, nil)
+	if err != nil {
+		m.log.Error(err, "Failed to read the cloud profile")
+		s.instance.UpdateStatePending(
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonGardenerError,
+			"False",
+			fmt.Sprintf("Gardener API cloud profile error: %v", err),
+		)
+		return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+	}
+
+	if len(cloudProfileErrs) > 0 {
+		m.log.Error(cloudProfileErrs.ToAggregate(), "Runtime is not valid for the cloud profile")
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonCloudProfileError,
+			fmt.Sprintf("Runtime cloud profile validation error: %v", cloudProfileErrs.ToAggregate()))
 	}
 
 	err = m.ShootClient.Create(ctx, &shoot)
@@ -0,0 +1,49 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerhelper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// sFnHandleHibernation keeps the Runtime of the hibernated shoot in the Hibernated state.
+// The API server of the hibernated shoot is not running, so the kubeconfig, OIDC and cluster role bindings are configured again when the shoot wakes up.
+func sFnHandleHibernation(_ context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	m.log.Info("Handle hibernation state")
+
+	if !isShootHibernated(s.shoot) || isShootWakingUp(s.shoot) {
+		m.log.Info(fmt.Sprintf("Shoot %s is waking up, scheduling for retry", s.shoot.Name))
+
+		s.instance.UpdateCondition(
+			imv1.ConditionTypeHibernated,
+			imv1.ConditionReasonWakingUp,
+			"False",
+			"Shoot is waking up")
+
+		s.instance.UpdateStatePending(
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonProcessing,
+			"Unknown",
+			"Shoot is waking up")
+
+		return updateStatusAndRequeueAfter(m.RCCfg.RequeueDurationShootReconcile)
+	}
+
+	m.log.Info(fmt.Sprintf("Shoot %s is hibernated, exiting with no retry", s.shoot.Name))
+	s.instance.UpdateStateHibernated("Shoot is hibernated")
+
+	return updateStatusAndStop()
+}
+
+func isShootHibernated(shoot *gardener.Shoot) bool {
+	return shoot.Status.IsHibernated
+}
+
+// isShootWakingUp returns true if the hibernated shoot is not supposed to be hibernated anymore
+func isShootWakingUp(shoot *gardener.Shoot) bool {
+	return shoot.Status.IsHibernated && !gardenerhelper.HibernationIsEnabled(shoot)
+}
@@ -0,0 +1,108 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("KIM sFnHandleHibernation", func() {
+	testCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	hibernatedShoot := &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-shoot",
+			Namespace: "garden-",
+		},
+		Spec: gardener.ShootSpec{
+			Hibernation: &gardener.Hibernation{Enabled: ptr.To(true)},
+		},
+		Status: gardener.ShootStatus{
+			IsHibernated: true,
+			LastOperation: &gardener.LastOperation{
+				Type:  gardener.LastOperationTypeReconcile,
+				State: gardener.LastOperationStateSucceeded,
+			},
+		},
+	}
+
+	wakingUpShoot := hibernatedShoot.DeepCopy()
+	wakingUpShoot.Spec.Hibernation.Enabled = ptr.To(false)
+	wakingUpShoot.Status.LastOperation.State = gardener.LastOperationStateProcessing
+
+	awakeShoot := hibernatedShoot.DeepCopy()
+	awakeShoot.Spec.Hibernation.Enabled = ptr.To(false)
+	awakeShoot.Status.IsHibernated = false
+
+	readyRuntime := makeInputRuntimeWithAnnotation(nil)
+	readyRuntime.Status.State = imv1.RuntimeStateReady
+
+	hibernatedRuntime := makeInputRuntimeWithAnnotation(nil)
+	hibernatedRuntime.UpdateStateHibernated("Shoot is hibernated")
+
+	testFunction := buildTestFunction(sFnHandleHibernation)
+
+	DescribeTable(
+		"transition graph validation for sFnHandleHibernation",
+		testFunction,
+		Entry(
+			"should set the Hibernated state and stop when the shoot is hibernated",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer),
+			&systemState{instance: *readyRuntime, shoot: hibernatedShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+				StateMatch: []types.GomegaMatcher{
+					HaveField("Status.State", BeEquivalentTo(imv1.RuntimeStateHibernated)),
+					haveConditionReasonAndStatus(imv1.ConditionTypeHibernated, imv1.ConditionReasonHibernated, metav1.ConditionTrue),
+				},
+			},
+		),
+		Entry(
+			"should set the Pending state and requeue when the shoot is waking up",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer),
+			&systemState{instance: *hibernatedRuntime, shoot: wakingUpShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+				StateMatch: []types.GomegaMatcher{
+					HaveField("Status.State", BeEquivalentTo(imv1.RuntimeStatePending)),
+					haveConditionReasonAndStatus(imv1.ConditionTypeHibernated, imv1.ConditionReasonWakingUp, metav1.ConditionFalse),
+					haveConditionReasonAndStatus(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonProcessing, metav1.ConditionUnknown),
+				},
+			},
+		),
+		Entry(
+			"should set the Pending state and requeue when the shoot of the hibernated runtime is awake",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer),
+			&systemState{instance: *hibernatedRuntime, shoot: awakeShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+				StateMatch: []types.GomegaMatcher{
+					HaveField("Status.State", BeEquivalentTo(imv1.RuntimeStatePending)),
+					haveConditionReasonAndStatus(imv1.ConditionTypeHibernated, imv1.ConditionReasonWakingUp, metav1.ConditionFalse),
+				},
+			},
+		),
+	)
+})
+
+func haveConditionReasonAndStatus(conditionType imv1.RuntimeConditionType, reason imv1.RuntimeConditionReason, status metav1.ConditionStatus) types.GomegaMatcher {
+	return HaveField("Status.Conditions", ContainElement(SatisfyAll(
+		HaveField("Type", string(conditionType)),
+		HaveField("Reason", string(reason)),
+		HaveField("Status", status),
+	)))
+}
@@ -5,6 +5,7 @@ import (
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -24,8 +25,23 @@ func sFnInitialize(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.
 
 	// instance is being deleted
 	if instanceIsBeingDeleted {
+		if s.shoot != nil && s.shoot.GetDeletionTimestamp().IsZero() {
+			if reconciler.IsDeletionProtected(s.instance.Annotations) {
+				m.log.Info("Instance is protected from deletion")
+				return blockDeletionAndStop(s)
+			}
+
+			if reconciler.ShouldOrphanShoot(s.instance.Annotations) {
+				m.log.Info("Release shoot from the instance")
+				return switchState(sFnReleaseShoot)
+			}
+		}
+
 		if s.shoot != nil {
 			m.log.Info("Delete instance resources")
+			if len(m.PreDeleteHooks) > 0 {
+				return switchState(sFnRunPreDeleteHooks)
+			}
 			return switchState(sFnDeleteKubeconfig)
 		}
 
@@ -35,6 +51,11 @@ func sFnInitialize(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.
 		return stopWithMetrics()
 	}
 
+	if reconciler.ShouldAdoptShoot(s.instance.Annotations) {
+		m.log.Info("Adopting existing Gardener shoot")
+		return switchState(sFnAdoptShoot)
+	}
+
 	if s.shoot == nil && provisioningCondition == nil {
 		m.log.Info("Update Runtime state to Pending - initialised")
 
@@ -7,6 +7,7 @@ import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
 	"github.com/onsi/gomega/types"
@@ -90,6 +91,31 @@ var _ = Describe("KIM sFnInitialise", func() {
 		},
 	}
 
+	testRtWithDeletionProtection := imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"test-me-plz"},
+			Annotations:       map[string]string{reconciler.DeletionProtectionAnnotation: "true"},
+		},
+	}
+
+	testRtWithOrphanPolicy := imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"test-me-plz"},
+			Annotations:       map[string]string{reconciler.DeletionPolicyAnnotation: reconciler.DeletionPolicyOrphan},
+		},
+	}
+
+	testRtWithAdoptAnnotation := imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-instance",
+			Namespace:   "default",
+			Finalizers:  []string{"test-me-plz"},
+			Annotations: map[string]string{reconciler.AdoptShootAnnotation: "true"},
+		},
+	}
+
 	testShoot := gardener.Shoot{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-instance",
@@ -134,6 +160,31 @@ var _ = Describe("KIM sFnInitialise", func() {
 				MatchNextFnState: haveName("sFnDeleteKubeconfig"),
 			},
 		),
+		Entry(
+			"should return sFnUpdateStatus and no error when CR is being deleted with deletion protection - Block deletion",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withMockedMetrics(), withDefaultReconcileDuration()),
+			&systemState{instance: testRtWithDeletionProtection, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+				StateMatch: []types.GomegaMatcher{
+					WithTransform(func(rt *imv1.Runtime) bool {
+						return rt.IsConditionSet(imv1.ConditionTypeDeletionBlocked, imv1.ConditionReasonDeletionProtected)
+					}, BeTrue()),
+				},
+			},
+		),
+		Entry(
+			"should return sFnReleaseShoot and no error when CR is being deleted with orphan deletion policy",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withMockedMetrics(), withDefaultReconcileDuration()),
+			&systemState{instance: testRtWithOrphanPolicy, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnReleaseShoot"),
+			},
+		),
 		Entry(
 			"should return sFnUpdateStatus and no error when CR has been created without finalizer - Add finalizer",
 			testCtx,
@@ -155,6 +206,16 @@ var _ = Describe("KIM sFnInitialise", func() {
 				MatchNextFnState: haveName("sFnUpdateStatus"),
 			},
 		),
+		Entry(
+			"should return sFnAdoptShoot and no error when CR has adopt shoot annotation",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withMockedMetrics(), withDefaultReconcileDuration()),
+			&systemState{instance: testRtWithAdoptAnnotation, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnAdoptShoot"),
+			},
+		),
 		Entry(
 			"should return sFnCreateShoot and no error when exists Provisioning Condition and shoot is missing",
 			testCtx,
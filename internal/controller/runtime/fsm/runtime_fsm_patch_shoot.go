@@ -36,26 +36,49 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 			msgFailedToConfigureAuditlogs)
 	}
 
+	getCloudProfile := m.cloudProfileGetter(ctx)
+
 	// NOTE: In the future we want to pass the whole shoot object here
 	updatedShoot, err := convertPatch(&s.instance, gardener_shoot.PatchOpts{
-		ConverterConfig:      m.ConverterConfig,
-		AuditLogData:         data,
-		Workers:              s.shoot.Spec.Provider.Workers,
-		ShootK8SVersion:      s.shoot.Spec.Kubernetes.Version,
-		Extensions:           s.shoot.Spec.Extensions,
-		Resources:            s.shoot.Spec.Resources,
-		InfrastructureConfig: s.shoot.Spec.Provider.InfrastructureConfig,
-		ControlPlaneConfig:   s.shoot.Spec.Provider.ControlPlaneConfig,
+		ConverterConfig:       m.ConverterConfig,
+		AuditLogData:          data,
+		Workers:               s.shoot.Spec.Provider.Workers,
+		ShootK8SVersion:       s.shoot.Spec.Kubernetes.Version,
+		Extensions:            s.shoot.Spec.Extensions,
+		Resources:             s.shoot.Spec.Resources,
+		InfrastructureConfig:  s.shoot.Spec.Provider.InfrastructureConfig,
+		ControlPlaneConfig:    s.shoot.Spec.Provider.ControlPlaneConfig,
+		CloudProfileName:      s.shoot.Spec.CloudProfileName,
+		ExposureClassName:     s.shoot.Spec.ExposureClassName,
+		MaintenanceTimeWindow: maintenanceTimeWindowOf(s.shoot),
 	})
 
 	if err != nil {
 		m.log.Error(err, "Failed to convert Runtime instance to shoot object, exiting with no retry")
 		m.Metrics.IncRuntimeFSMStopCounter()
-		return updateStatePendingWithErrorAndStop(&s.instance, imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonConversionError, "Runtime conversion error")
+		return updateStatePendingWithErrorAndStop(&s.instance, imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonConversionError, fmt.Sprintf("Runtime conversion error: %v", err))
 	}
 
 	m.log.Info("Shoot converted successfully", "Name", updatedShoot.Name, "Namespace", updatedShoot.Namespace)
 
+	nextState, res, err := handleKubernetesUpgrade(m, s, getCloudProfile, &updatedShoot)
+	if nextState != nil {
+		return nextState, res, err
+	}
+
+	cloudProfileErrs, err := validateWithCloudProfile(getCloudProfile, &updatedShoot, s.shoot)
+	if err != nil {
+		m.log.Error(err, "Failed to read the cloud profile, scheduling for retry")
+		s.instance.UpdateStatePending(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonGardenerError, "False", fmt.Sprintf("Gardener API cloud profile error: %v", err))
+		return updateStatusAndRequeueAfter(m.RCCfg.GardenerRequeueDuration)
+	}
+
+	if len(cloudProfileErrs) > 0 {
+		m.log.Error(cloudProfileErrs.ToAggregate(), "Runtime is not valid for the cloud profile, exiting with no retry")
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatePendingWithErrorAndStop(&s.instance, imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonCloudProfileError, fmt.Sprintf("Runtime cloud profile validation error: %v", cloudProfileErrs.ToAggregate()))
+	}
+
 	// The additional Update function is required to fully replace shoot Workers collection with workers defined in updated runtime object.
 	// This is a workaround for the sigs.k8s.io/controller-runtime/pkg/client, which does not support replacing the Workers collection with client.Patch
 	// This could caused some workers to be not removed from the shoot object during update
@@ -68,9 +91,9 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 		updateErr := m.ShootClient.Update(ctx, copyShoot,
 			&client.UpdateOptions{
 				FieldManager: fieldManagerName,
-		})
+			})
 
-		nextState, res, err := handleUpdateError(updateErr, m, s, "Failed to patch shoot object, exiting with no retry", "Gardener API shoot patch error")
+		nextState, res, err = handleUpdateError(updateErr, m, s, "Failed to patch shoot object, exiting with no retry", "Gardener API shoot patch error")
 
 		if nextState != nil {
 			return nextState, res, err
@@ -81,7 +104,7 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 		FieldManager: fieldManagerName,
 		Force:        ptr.To(true),
 	})
-	nextState, res, err := handleUpdateError(patchErr, m, s, "Failed to patch shoot object, exiting with no retry", "Gardener API shoot patch error")
+	nextState, res, err = handleUpdateError(patchErr, m, s, "Failed to patch shoot object, exiting with no retry", "Gardener API shoot patch error")
 
 	if nextState != nil {
 		return nextState, res, err
@@ -94,6 +117,12 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 	}
 
 	if updatedShoot.Generation == s.shoot.Generation {
+		// the API server of the hibernated shoot is not running, so the kubeconfig, OIDC and cluster role bindings cannot be configured
+		if isShootHibernated(s.shoot) {
+			m.log.Info("Gardener shoot for runtime did not change after patch, shoot is hibernated", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+			return switchState(sFnHandleHibernation)
+		}
+
 		m.log.Info("Gardener shoot for runtime did not change after patch, moving to processing", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
 		return switchState(sFnHandleKubeconfig)
 	}
@@ -8,14 +8,19 @@ import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
 	"github.com/onsi/gomega/types"
 	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	util "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 var _ = Describe("KIM sFnPatchExistingShoot", func() {
@@ -54,6 +59,19 @@ var _ = Describe("KIM sFnPatchExistingShoot", func() {
 		},
 	}
 
+	inputRtWithUnknownZone := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true"})
+	inputRtWithUnknownZone.Spec.Shoot.Provider.Workers[0].Zones = []string{"europe-west1-x"}
+
+	inputRtWithUnknownMachineType := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true"})
+	inputRtWithUnknownMachineType.Spec.Shoot.Provider.Workers[0].Machine.Type = "n2-unknown"
+
+	testCloudProfile := fixCloudProfile("gcp", "region", "europe-west1-b", "europe-west1-c", "europe-west1-d")
+	testCloudProfile.Spec.MachineTypes = []gardener.MachineType{{Name: "m5.xlarge"}}
+	testCloudProfile.Spec.MachineImages = []gardener.MachineImage{{
+		Name:     "garden-linux",
+		Versions: []gardener.MachineImageVersion{{ExpirableVersion: gardener.ExpirableVersion{Version: "1.19.8"}}},
+	}}
+
 	testFunction := buildPatchTestFunction(sFnPatchExistingShoot)
 
 	var expectedAnnotations map[string]string
@@ -64,14 +82,143 @@ var _ = Describe("KIM sFnPatchExistingShoot", func() {
 		Entry(
 			"should update status after succesful patching and remove force patch annotation",
 			testCtx,
-			must(newFakeFSM, withMockedMetrics(), withTestFinalizer, withFakedK8sClient(testScheme, inputRtWithForceAnnotation), withFakeEventRecorder(1)),
+			must(newFakeFSM, withMockedMetrics(), withTestFinalizer, withFakedK8sClient(testScheme, inputRtWithForceAnnotation, testCloudProfile), withFakeEventRecorder(1)),
 			&systemState{instance: *inputRtWithForceAnnotation, shoot: &testShoot},
 			haveName("sFnUpdateStatus"),
 			expectedAnnotations,
 		),
+		Entry(
+			"should stop without patching when a worker zone is not available in the cloud profile region",
+			testCtx,
+			must(newFakeFSM, withMockedMetrics(), withTestFinalizer, withFakedK8sClient(testScheme, inputRtWithUnknownZone, testCloudProfile), withFakeEventRecorder(1)),
+			&systemState{instance: *inputRtWithUnknownZone, shoot: &testShoot},
+			haveName("sFnUpdateStatus"),
+			map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true"},
+		),
+		Entry(
+			"should stop without patching when a machine type is not available in the cloud profile",
+			testCtx,
+			must(newFakeFSM, withMockedMetrics(), withTestFinalizer, withFakedK8sClient(testScheme, inputRtWithUnknownMachineType, testCloudProfile), withFakeEventRecorder(1)),
+			&systemState{instance: *inputRtWithUnknownMachineType, shoot: &testShoot},
+			haveName("sFnUpdateStatus"),
+			map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true"},
+		),
 	)
+
+	It("should switch to sFnHandleHibernation when the patch does not change the hibernated shoot", func() {
+		// given
+		inputRt := makeInputRuntimeWithAnnotation(nil)
+
+		shoot := testShoot.DeepCopy()
+		shoot.ResourceVersion = ""
+		shoot.Spec.Hibernation = &gardener.Hibernation{Enabled: ptr.To(true)}
+		shoot.Status.IsHibernated = true
+
+		shootClient := fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(testCloudProfile.DeepCopy(), shoot).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					return nil
+				},
+				Update: fsm_testing.GetFakeUpdateInterceptorFn(),
+			}).Build()
+
+		fsm := must(newFakeFSM, withMockedMetrics(), withTestFinalizer, withFakedK8sClient(testScheme, inputRt), withFakeEventRecorder(1))
+		fsm.ShootClient = shootClient
+
+		s := &systemState{instance: *inputRt, shoot: shoot}
+
+		// when
+		stateFn, _, err := sFnPatchExistingShoot(testCtx, fsm, s)
+
+		// then
+		Expect(err).To(BeNil())
+		Expect(stateFn).To(haveName("sFnHandleHibernation"))
+	})
+
+	It("should upgrade the Kubernetes version by one minor at a time", func() {
+		// given
+		inputRt := makeInputRuntimeWithAnnotation(nil)
+		inputRt.Spec.Shoot.Kubernetes.Version = ptr.To("1.31.1")
+
+		shoot := testShoot.DeepCopy()
+		shoot.ResourceVersion = ""
+		shoot.Spec.Kubernetes.Version = "1.29.10"
+
+		cloudProfile := testCloudProfile.DeepCopy()
+		cloudProfile.ResourceVersion = ""
+		cloudProfile.Spec.Kubernetes.Versions = []gardener.ExpirableVersion{
+			{Version: "1.31.1"},
+			{Version: "1.30.3"},
+			{Version: "1.30.2"},
+			{Version: "1.29.10"},
+		}
+
+		var patchedVersion string
+		shootClient := fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(cloudProfile, shoot).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					patchedShoot := obj.(*gardener.Shoot)
+					patchedVersion = patchedShoot.Spec.Kubernetes.Version
+					patchedShoot.Generation++
+					return nil
+				},
+				Update: fsm_testing.GetFakeUpdateInterceptorFn(),
+			}).Build()
+
+		fsm := must(newFakeFSM, withMockedMetrics(), withTestFinalizer, withFakedK8sClient(testScheme, inputRt), withFakeEventRecorder(1))
+		fsm.ShootClient = shootClient
+
+		s := &systemState{instance: *inputRt, shoot: shoot}
+
+		// when
+		_, _, err := sFnPatchExistingShoot(testCtx, fsm, s)
+
+		// then
+		Expect(err).To(BeNil())
+		Expect(patchedVersion).To(Equal("1.30.3"))
+
+		condition := meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeUpgradeInProgress))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(string(imv1.ConditionReasonKubernetesUpgradeStep)))
+		Expect(condition.Message).To(Equal("Upgrading Kubernetes from 1.29.10 to 1.30.3, target version is 1.31.1"))
+
+		// when
+		s.shoot.Spec.Kubernetes.Version = patchedVersion
+		_, _, err = sFnPatchExistingShoot(testCtx, fsm, s)
+
+		// then
+		Expect(err).To(BeNil())
+		Expect(patchedVersion).To(Equal("1.31.1"))
+		Expect(meta.IsStatusConditionTrue(s.instance.Status.Conditions, string(imv1.ConditionTypeUpgradeInProgress))).To(BeTrue())
+
+		// when
+		s.shoot.Spec.Kubernetes.Version = patchedVersion
+		_, _, err = sFnPatchExistingShoot(testCtx, fsm, s)
+
+		// then
+		Expect(err).To(BeNil())
+		Expect(meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeUpgradeInProgress))).To(BeNil())
+	})
 })
 
+func fixCloudProfile(name, region string, zones ...string) *gardener.CloudProfile {
+	cloudProfile := &gardener.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: gardener.CloudProfileSpec{
+			Regions: []gardener.Region{{Name: region}},
+		},
+	}
+	for _, zone := range zones {
+		cloudProfile.Spec.Regions[0].Zones = append(cloudProfile.Spec.Regions[0].Zones, gardener.AvailabilityZone{Name: zone})
+	}
+	return cloudProfile
+}
+
 func buildPatchTestFunction(fn stateFn) func(context.Context, *fsm, *systemState, types.GomegaMatcher, map[string]string) {
 	return func(ctx context.Context, r *fsm, s *systemState, matchNextFnState types.GomegaMatcher, expectedAnnotations map[string]string) {
 
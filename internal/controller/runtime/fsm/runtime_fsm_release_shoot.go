@@ -0,0 +1,72 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// sFnReleaseShoot removes the KIM annotations from the shoot and lets the Runtime CR go without deleting the cluster
+func sFnReleaseShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	m.log.Info("release shoot state")
+
+	if !s.shoot.GetDeletionTimestamp().IsZero() {
+		// shoot deletion cannot be reverted
+		m.log.Info("Shoot is already being deleted and cannot be released", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+		return switchState(sFnDeleteShoot)
+	}
+
+	if hasKIMAnnotations(s.shoot.Annotations) {
+		patch := client.MergeFrom(s.shoot.DeepCopy())
+		s.shoot.Annotations = withoutKIMAnnotations(s.shoot.Annotations)
+
+		if err := m.ShootClient.Patch(ctx, s.shoot, patch); err != nil {
+			m.log.Error(err, "Failed to release shoot, scheduling for retry", "Name", s.shoot.Name)
+			s.instance.UpdateStateDeletion(
+				imv1.ConditionTypeRuntimeDeprovisioned,
+				imv1.ConditionReasonGardenerError,
+				"False",
+				fmt.Sprintf("Gardener API shoot patch error: %v", err),
+			)
+			return updateStatusAndRequeueAfter(m.RCCfg.GardenerRequeueDuration)
+		}
+	}
+
+	m.log.Info("Shoot released, it will not be deleted", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+	return removeFinalizerAndStop(ctx, m, s)
+}
+
+func blockDeletionAndStop(s *systemState) (stateFn, *ctrl.Result, error) {
+	s.instance.UpdateStateDeletion(
+		imv1.ConditionTypeDeletionBlocked,
+		imv1.ConditionReasonDeletionProtected,
+		"True",
+		fmt.Sprintf("Runtime is protected from deletion, remove the %s annotation to delete it", reconciler.DeletionProtectionAnnotation),
+	)
+	return updateStatusAndStop()
+}
+
+func hasKIMAnnotations(annotations map[string]string) bool {
+	for key := range annotations {
+		if strings.HasPrefix(key, extender.ShootKIMAnnotationPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutKIMAnnotations(annotations map[string]string) map[string]string {
+	result := map[string]string{}
+	for key, value := range annotations {
+		if !strings.HasPrefix(key, extender.ShootKIMAnnotationPrefix) {
+			result[key] = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReleaseShootState(t *testing.T) {
+	t.Run("Should remove KIM annotations from the shoot and remove finalizer", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		scheme := runtime.NewScheme()
+		util.Must(imv1.AddToScheme(scheme))
+		util.Must(gardener.AddToScheme(scheme))
+
+		shoot := shootForTest()
+		shoot.Annotations = map[string]string{
+			extender.ShootRuntimeIDAnnotation:         "runtime-id",
+			extender.ShootRuntimeGenerationAnnotation: "1",
+			"gardener.cloud/created-by":               "someone",
+		}
+
+		runtimeStub := runtimeForTest()
+		runtimeStub.Finalizers = []string{imv1.Finalizer}
+
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(shoot, &runtimeStub).
+			Build()
+
+		m := &mocks.Metrics{}
+		m.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
+
+		testFsm := &fsm{
+			K8s: K8s{
+				Client:      fakeClient,
+				ShootClient: fakeClient,
+			},
+			RCCfg: RCCfg{
+				Finalizer: imv1.Finalizer,
+				Metrics:   m,
+			},
+		}
+		systemState := &systemState{instance: runtimeStub, shoot: shoot}
+
+		// when
+		stateFn, _, err := sFnReleaseShoot(ctx, testFsm, systemState)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, stateFn)
+
+		var actualShoot gardener.Shoot
+		require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), &actualShoot))
+		assert.Equal(t, map[string]string{"gardener.cloud/created-by": "someone"}, actualShoot.Annotations)
+		assert.Empty(t, systemState.instance.Finalizers)
+	})
+
+	t.Run("Should continue deletion when shoot is already being deleted", func(t *testing.T) {
+		// given
+		shoot := shootForTest()
+		now := metav1.Now()
+		shoot.DeletionTimestamp = &now
+		systemState := &systemState{instance: runtimeForTest(), shoot: shoot}
+
+		// when
+		stateFn, _, _ := sFnReleaseShoot(context.Background(), &fsm{}, systemState)
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnDeleteShoot")
+	})
+}
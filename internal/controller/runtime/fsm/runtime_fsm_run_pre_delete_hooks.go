@@ -0,0 +1,110 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func sFnRunPreDeleteHooks(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	m.log.Info("run pre-delete hooks state")
+
+	if preDeleteHooksFinished(s.instance) {
+		return switchState(sFnDeleteKubeconfig)
+	}
+
+	if reconciler.ShouldSkipPreDeleteHooks(s.instance.Annotations) {
+		m.log.Info("Pre-delete hooks skipped", "Runtime", s.instance.Name)
+		updateDeletionUnblocked(&s.instance, imv1.ConditionReasonPreDeleteHooksSkipped, "Pre-delete hooks skipped")
+		return updateStatusAndRequeue()
+	}
+
+	// the API server of the hibernated shoot is not running, so the hooks could not complete before the deadline
+	if s.shoot != nil && isShootHibernated(s.shoot) {
+		m.log.Info("Pre-delete hooks skipped, shoot is hibernated", "Runtime", s.instance.Name)
+		updateDeletionUnblocked(&s.instance, imv1.ConditionReasonPreDeleteHooksSkipped, "Pre-delete hooks skipped, shoot is hibernated")
+		return updateStatusAndRequeue()
+	}
+
+	deadline := s.instance.GetDeletionTimestamp().Add(m.RCCfg.PreDeleteHooksTimeout)
+	if time.Now().After(deadline) {
+		// deletion stays blocked until the hooks are skipped with the annotation
+		m.log.Info("Pre-delete hooks not completed in time, deletion is blocked", "Runtime", s.instance.Name)
+		s.instance.UpdateStateDeletion(
+			imv1.ConditionTypeDeletionBlocked,
+			imv1.ConditionReasonPreDeleteHooksTimeout,
+			"True",
+			fmt.Sprintf("Pre-delete hooks not completed within %s", m.RCCfg.PreDeleteHooksTimeout),
+		)
+		m.Metrics.IncRuntimeFSMStopCounter()
+		return updateStatusAndStop()
+	}
+
+	shootAdminClient, err := GetShootClient(ctx, m.Client, s.instance)
+	if err != nil {
+		m.log.Error(err, "Failed to get runtime client for pre-delete hooks, scheduling for retry")
+		s.instance.UpdateStateDeletion(
+			imv1.ConditionTypeDeletionBlocked,
+			imv1.ConditionReasonPreDeleteHookError,
+			"True",
+			fmt.Sprintf("failed to get runtime client: %v", err),
+		)
+		return updateStatusAndRequeueAfter(m.RCCfg.ControlPlaneRequeueDuration)
+	}
+
+	hookCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	for _, hook := range m.PreDeleteHooks {
+		done, err := hook.Run(hookCtx, s.instance, shootAdminClient)
+		if err != nil {
+			m.log.Error(err, "Pre-delete hook failed, scheduling for retry", "hook", hook.Name())
+			s.instance.UpdateStateDeletion(
+				imv1.ConditionTypeDeletionBlocked,
+				imv1.ConditionReasonPreDeleteHookError,
+				"True",
+				fmt.Sprintf("pre-delete hook %s failed: %v", hook.Name(), err),
+			)
+			return updateStatusAndRequeueAfter(m.RCCfg.ControlPlaneRequeueDuration)
+		}
+
+		if !done {
+			m.log.Info("Waiting for pre-delete hook to complete", "hook", hook.Name())
+			s.instance.UpdateStateDeletion(
+				imv1.ConditionTypeDeletionBlocked,
+				imv1.ConditionReasonPreDeleteHooksPending,
+				"Unknown",
+				fmt.Sprintf("Waiting for pre-delete hook %s", hook.Name()),
+			)
+			return updateStatusAndRequeueAfter(m.RCCfg.ControlPlaneRequeueDuration)
+		}
+	}
+
+	m.log.Info("Pre-delete hooks completed", "Runtime", s.instance.Name)
+	updateDeletionUnblocked(&s.instance, imv1.ConditionReasonPreDeleteHooksCompleted, "Pre-delete hooks completed")
+	return updateStatusAndRequeue()
+}
+
+func preDeleteHooksFinished(rt imv1.Runtime) bool {
+	return rt.IsConditionSetWithStatus(imv1.ConditionTypeDeletionBlocked, imv1.ConditionReasonPreDeleteHooksCompleted, metav1.ConditionFalse) ||
+		rt.IsConditionSetWithStatus(imv1.ConditionTypeDeletionBlocked, imv1.ConditionReasonPreDeleteHooksSkipped, metav1.ConditionFalse)
+}
+
+// updateDeletionUnblocked keeps the Terminating state and sets DeletionBlocked=False, so the deletion proceeds on the next reconciliation.
+// UpdateStateDeletion cannot be used, because it sets the Failed state for the False conditions.
+func updateDeletionUnblocked(rt *imv1.Runtime, reason imv1.RuntimeConditionReason, msg string) {
+	rt.Status.State = imv1.RuntimeStateTerminating
+	meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+		Type:               string(imv1.ConditionTypeDeletionBlocked),
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(reason),
+		Message:            msg,
+	})
+}
@@ -0,0 +1,148 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	"github.com/kyma-project/infrastructure-manager/pkg/predelete"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeHook struct {
+	done  bool
+	err   error
+	calls int
+}
+
+func (h *fakeHook) Name() string {
+	return "fake"
+}
+
+func (h *fakeHook) Run(_ context.Context, _ imv1.Runtime, _ client.Client) (bool, error) {
+	h.calls++
+	return h.done, h.err
+}
+
+func TestRunPreDeleteHooksState(t *testing.T) {
+	GetShootClient = func(_ context.Context, _ client.Client, _ imv1.Runtime) (client.Client, error) {
+		return fake.NewClientBuilder().Build(), nil
+	}
+
+	for _, testCase := range []struct {
+		name              string
+		hook              *fakeHook
+		annotations       map[string]string
+		hibernated        bool
+		deletedAgo        time.Duration
+		expectedReason    imv1.RuntimeConditionReason
+		expectedStatus    metav1.ConditionStatus
+		expectedHookCalls int
+	}{
+		{
+			name:              "Should unblock deletion when hooks are completed",
+			hook:              &fakeHook{done: true},
+			expectedReason:    imv1.ConditionReasonPreDeleteHooksCompleted,
+			expectedStatus:    metav1.ConditionFalse,
+			expectedHookCalls: 1,
+		},
+		{
+			name:              "Should wait when hook is not completed",
+			hook:              &fakeHook{done: false},
+			expectedReason:    imv1.ConditionReasonPreDeleteHooksPending,
+			expectedStatus:    metav1.ConditionUnknown,
+			expectedHookCalls: 1,
+		},
+		{
+			name:              "Should block deletion when hook fails",
+			hook:              &fakeHook{err: errors.New("test error")},
+			expectedReason:    imv1.ConditionReasonPreDeleteHookError,
+			expectedStatus:    metav1.ConditionTrue,
+			expectedHookCalls: 1,
+		},
+		{
+			name:              "Should block deletion when hooks are not completed in time",
+			hook:              &fakeHook{done: false},
+			deletedAgo:        2 * time.Hour,
+			expectedReason:    imv1.ConditionReasonPreDeleteHooksTimeout,
+			expectedStatus:    metav1.ConditionTrue,
+			expectedHookCalls: 0,
+		},
+		{
+			name:              "Should skip hooks when annotation is set",
+			hook:              &fakeHook{err: errors.New("test error")},
+			annotations:       map[string]string{reconciler.SkipPreDeleteHooksAnnotation: "true"},
+			deletedAgo:        2 * time.Hour,
+			expectedReason:    imv1.ConditionReasonPreDeleteHooksSkipped,
+			expectedStatus:    metav1.ConditionFalse,
+			expectedHookCalls: 0,
+		},
+		{
+			name:              "Should skip hooks when shoot is hibernated",
+			hook:              &fakeHook{done: false},
+			hibernated:        true,
+			expectedReason:    imv1.ConditionReasonPreDeleteHooksSkipped,
+			expectedStatus:    metav1.ConditionFalse,
+			expectedHookCalls: 0,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			m := &mocks.Metrics{}
+			m.On("IncRuntimeFSMStopCounter").Return()
+
+			testFsm := &fsm{RCCfg: RCCfg{
+				PreDeleteHooks:        predelete.Hooks{testCase.hook},
+				PreDeleteHooksTimeout: time.Hour,
+				Metrics:               m,
+			}}
+
+			runtime := runtimeForTest()
+			runtime.Annotations = testCase.annotations
+			runtime.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-testCase.deletedAgo)}
+			shoot := shootForTest()
+			shoot.Status.IsHibernated = testCase.hibernated
+			systemState := &systemState{instance: runtime, shoot: shoot}
+
+			// when
+			stateFn, _, _ := sFnRunPreDeleteHooks(ctx, testFsm, systemState)
+
+			// then
+			require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+			assert.Equal(t, testCase.expectedHookCalls, testCase.hook.calls)
+			assert.Equal(t, imv1.State(imv1.RuntimeStateTerminating), systemState.instance.Status.State)
+
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeDeletionBlocked))
+			require.NotNil(t, condition)
+			assert.Equal(t, string(testCase.expectedReason), condition.Reason)
+			assert.Equal(t, testCase.expectedStatus, condition.Status)
+		})
+	}
+
+	t.Run("Should switch to kubeconfig deletion when hooks are completed", func(t *testing.T) {
+		// given
+		hook := &fakeHook{}
+		testFsm := &fsm{RCCfg: RCCfg{PreDeleteHooks: predelete.Hooks{hook}}}
+
+		runtime := runtimeForTest()
+		updateDeletionUnblocked(&runtime, imv1.ConditionReasonPreDeleteHooksCompleted, "Pre-delete hooks completed")
+		systemState := &systemState{instance: runtime}
+
+		// when
+		stateFn, _, _ := sFnRunPreDeleteHooks(context.Background(), testFsm, systemState)
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnDeleteKubeconfig")
+		assert.Zero(t, hook.calls)
+	})
+}
@@ -3,6 +3,7 @@ package fsm
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -13,7 +14,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+func sFnSelectShootProcessing(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
 	m.log.Info("Select shoot processing state")
 
 	if s.shoot.Spec.DNS == nil || s.shoot.Spec.DNS.Domain == nil {
@@ -29,6 +30,8 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 		return requeueAfter(m.RCCfg.GardenerRequeueDuration)
 	}
 
+	m.updateVersionLifecycle(ctx, s)
+
 	patchShoot, err := shouldPatchShoot(&s.instance, s.shoot, &m.log)
 	if err != nil {
 		m.log.Error(err, "Failed to get applied generation for shoot", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
@@ -41,6 +44,15 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 		return switchState(sFnPatchExistingShoot)
 	}
 
+	if isUpgradeInProgress(&s.instance) && !reconciler.ShouldSuspendReconciliation(s.instance.Annotations) {
+		m.log.Info("Kubernetes upgrade is in progress, waiting for the shoot reconciliation", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return switchState(sFnWaitForShootReconcile)
+	}
+
+	if isShootHibernated(s.shoot) || s.instance.Status.State == imv1.RuntimeStateHibernated {
+		return switchState(sFnHandleHibernation)
+	}
+
 	if s.instance.Status.State == imv1.RuntimeStatePending || s.instance.Status.State == "" {
 		if lastOperation.Type == gardener.LastOperationTypeCreate {
 			return switchState(sFnWaitForShootCreation)
@@ -53,6 +65,10 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 
 	// All other runtimes in Ready and Failed state will be not processed to mitigate massive reconciliation during restart
 	m.log.Info("Stopping processing reconcile, exiting with no retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "function", "sFnSelectShootProcessing")
+	if !reflect.DeepEqual(s.instance.Status, s.snapshot) {
+		// the version lifecycle changed
+		return updateStatusAndStop()
+	}
 	return stop()
 }
 
@@ -10,6 +10,7 @@ import (
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	util "k8s.io/apimachinery/pkg/util/runtime"
@@ -24,6 +25,7 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 	// GIVEN
 	testScheme := runtime.NewScheme()
 	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
 	withTestSchemeAndObjects := func(objs ...client.Object) fakeFSMOpt {
 		return func(fsm *fsm) error {
 			return withFakedK8sClient(testScheme, objs...)(fsm)
@@ -50,6 +52,39 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 		},
 	}
 
+	inputRtReadyWithSuspendAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/suspend-patch-reconciliation": "true"})
+	inputRtReadyWithSuspendAnnotation.Status.State = imv1.RuntimeStateReady
+
+	testShootWithCloudProfile := testShoot.DeepCopy()
+	testShootWithCloudProfile.Spec.CloudProfileName = ptr.To("gcp")
+	testShootWithCloudProfile.Spec.Kubernetes.Version = "1.30.2"
+	testShootWithCloudProfile.Spec.Provider.Workers = fixWorkers("test-worker", "m5.xlarge", "garden-linux", "1.19.8", 1, 1, []string{"europe-west1-d"})
+
+	testCloudProfile := fixCloudProfile("gcp", "region", "europe-west1-d")
+	testCloudProfile.Spec.Kubernetes.Versions = []gardener.ExpirableVersion{{
+		Version:        "1.30.2",
+		Classification: ptr.To(gardener.ClassificationDeprecated),
+		ExpirationDate: &metav1.Time{Time: time.Now().Add(72 * time.Hour)},
+	}}
+
+	withVersionExpirationWarningPeriod := func(fsm *fsm) error {
+		fsm.VersionExpirationWarningPeriod = 7 * 24 * time.Hour
+		return nil
+	}
+
+	inputRtWithUpgradeInProgress := makeInputRuntimeWithAnnotation(nil)
+	inputRtWithUpgradeInProgress.Status.State = imv1.RuntimeStateReady
+	inputRtWithUpgradeInProgress.UpdateCondition(imv1.ConditionTypeUpgradeInProgress, imv1.ConditionReasonKubernetesUpgradeStep, "True", "Upgrading Kubernetes from 1.29.10 to 1.30.3, target version is 1.31.1")
+
+	testShootWithAppliedGeneration := testShoot.DeepCopy()
+	testShootWithAppliedGeneration.Annotations = map[string]string{"infrastructuremanager.kyma-project.io/runtime-generation": "0"}
+
+	testShootHibernated := testShootWithAppliedGeneration.DeepCopy()
+	testShootHibernated.Status.IsHibernated = true
+
+	inputRtReady := makeInputRuntimeWithAnnotation(nil)
+	inputRtReady.Status.State = imv1.RuntimeStateReady
+
 	testFunction := buildTestFunction(sFnSelectShootProcessing)
 
 	DescribeTable(
@@ -75,6 +110,41 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 				MatchNextFnState: BeNil(),
 			},
 		),
+		Entry(
+			"should switch to sFnWaitForShootReconcile when Kubernetes upgrade is in progress",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects()),
+			&systemState{instance: *inputRtWithUpgradeInProgress, shoot: testShootWithAppliedGeneration},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnWaitForShootReconcile"),
+			},
+		),
+		Entry(
+			"should switch to sFnHandleHibernation when the shoot is hibernated",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects()),
+			&systemState{instance: *inputRtReady, shoot: testShootHibernated},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnHandleHibernation"),
+			},
+		),
+		Entry(
+			"should update the version lifecycle of ready runtime and stop",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects(testCloudProfile), withFakeEventRecorder(1), withVersionExpirationWarningPeriod),
+			&systemState{instance: *inputRtReadyWithSuspendAnnotation, shoot: testShootWithCloudProfile},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+				StateMatch: []types.GomegaMatcher{
+					HaveField("Status.VersionLifecycle.Kubernetes.Classification", Equal(imv1.VersionClassificationDeprecated)),
+					HaveField("Status.VersionLifecycle.Kubernetes.ExpiresSoon", BeTrue()),
+					HaveField("Status.VersionLifecycle.MachineImages", ConsistOf(HaveField("Name", "garden-linux"))),
+				},
+			},
+		),
 	)
 })
 
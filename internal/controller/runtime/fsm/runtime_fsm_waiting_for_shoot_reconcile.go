@@ -29,7 +29,7 @@ func sFnWaitForShootReconcile(_ context.Context, m *fsm, s *systemState) (stateF
 		lastErrors := s.shoot.Status.LastErrors
 		reason := imgardenerhandler.ToErrReason(lastErrors...)
 
-		if imgardenerhandler.IsRetryable(lastErrors) {
+		if imgardenerhandler.IsRetryable(lastErrors) || isShootWakingUp(s.shoot) {
 			m.log.Info(fmt.Sprintf("Retryable gardener errors during cluster provisioning for Shoot %s, reason: %s, scheduling for retry", s.shoot.Name, reason))
 			s.instance.UpdateStatePending(
 				imv1.ConditionTypeRuntimeProvisioned,
@@ -52,6 +52,21 @@ func sFnWaitForShootReconcile(_ context.Context, m *fsm, s *systemState) (stateF
 		return updateStatusAndStop()
 
 	case gardener.LastOperationStateSucceeded:
+		if isUpgradeInProgress(&s.instance) {
+			if !isShootReconciled(s.shoot) {
+				m.log.Info(fmt.Sprintf("Shoot %s reconciliation of the Kubernetes upgrade step is not started yet, scheduling for retry", s.shoot.Name))
+				return requeueAfter(m.RCCfg.RequeueDurationShootReconcile)
+			}
+
+			m.log.Info(fmt.Sprintf("Shoot %s Kubernetes upgrade step completed, moving to the next step", s.shoot.Name))
+			return switchState(sFnPatchExistingShoot)
+		}
+
+		if isShootHibernated(s.shoot) {
+			return switchState(sFnHandleHibernation)
+		}
+
+		s.instance.RemoveCondition(imv1.ConditionTypeHibernated)
 		m.log.Info(fmt.Sprintf("Shoot %s successfully updated, moving to processing", s.shoot.Name))
 		return ensureStatusConditionIsSetAndContinue(
 			&s.instance,
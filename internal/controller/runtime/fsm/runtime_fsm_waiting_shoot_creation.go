@@ -79,6 +79,11 @@ func sFnWaitForShootCreation(_ context.Context, m *fsm, s *systemState) (stateFn
 
 	case gardener.LastOperationStateSucceeded:
 		m.log.Info(fmt.Sprintf("Shoot %s successfully created", s.shoot.Name))
+		if isShootHibernated(s.shoot) {
+			return switchState(sFnHandleHibernation)
+		}
+
+		s.instance.RemoveCondition(imv1.ConditionTypeHibernated)
 		return ensureStatusConditionIsSetAndContinue(
 			&s.instance,
 			imv1.ConditionTypeRuntimeProvisioned,
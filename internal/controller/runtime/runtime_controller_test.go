@@ -255,13 +255,14 @@ func CreateRuntimeStub(resourceName string) *imv1.Runtime {
 		Spec: imv1.RuntimeSpec{
 			Shoot: imv1.RuntimeShoot{
 				Name:                resourceName,
-				Networking:          imv1.Networking{},
+				Networking:          imv1.Networking{Nodes: "10.250.0.0/22"},
 				EnforceSeedLocation: ptr.To(true),
 				Provider: imv1.Provider{
 					Type: "aws",
 					Workers: []gardener.Worker{
 						{
-							Zones:   []string{""},
+							Machine: gardener.Machine{Type: "m6i.large"},
+							Zones:   []string{"eu-central-1a"},
 							Maximum: 1,
 						},
 					},
@@ -110,6 +110,7 @@ var _ = BeforeSuite(func() {
 
 	// tracker will be updated with different shoot sequence for each test case
 	tracker := clienttesting.NewObjectTracker(clientScheme, serializer.NewCodecFactory(clientScheme).UniversalDecoder())
+	Expect(tracker.Add(fixCloudProfileForTests())).To(Succeed())
 	customTracker = NewCustomTracker(tracker, []*gardener_api.Shoot{}, []*gardener_api.SeedList{})
 	gardenerTestClient = fake.NewClientBuilder().WithScheme(clientScheme).WithObjectTracker(customTracker).Build()
 
@@ -192,6 +193,7 @@ func setupGardenerClientWithSequence(shoots []*gardener_api.Shoot, seeds []*gard
 	_ = gardener_api.AddToScheme(clientScheme)
 
 	tracker := clienttesting.NewObjectTracker(clientScheme, serializer.NewCodecFactory(clientScheme).UniversalDecoder())
+	Expect(tracker.Add(fixCloudProfileForTests())).To(Succeed())
 	customTracker = NewCustomTracker(tracker, shoots, seeds)
 	gardenerTestClient = fake.NewClientBuilder().WithScheme(clientScheme).WithObjectTracker(customTracker).
 		WithInterceptorFuncs(interceptor.Funcs{
@@ -256,6 +258,34 @@ func fixSeedSequenceForProvisioning(providerType string) []*gardener_api.SeedLis
 	}
 }
 
+func fixCloudProfileForTests() *gardener_api.CloudProfile {
+	return &gardener_api.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "aws",
+		},
+		Spec: gardener_api.CloudProfileSpec{
+			Kubernetes: gardener_api.KubernetesSettings{
+				Versions: []gardener_api.ExpirableVersion{{Version: "1.29"}},
+			},
+			MachineTypes: []gardener_api.MachineType{{Name: "m6i.large"}},
+			MachineImages: []gardener_api.MachineImage{{
+				Name:     "gardenlinux",
+				Versions: []gardener_api.MachineImageVersion{{ExpirableVersion: gardener_api.ExpirableVersion{Version: "1592.1.0"}}},
+			}},
+			Regions: []gardener_api.Region{
+				{
+					Name: "eu-central-1",
+					Zones: []gardener_api.AvailabilityZone{
+						{Name: "eu-central-1a"},
+						{Name: "eu-central-1b"},
+						{Name: "eu-central-1c"},
+					},
+				},
+			},
+		},
+	}
+}
+
 func getSeedForRegion(providerType, region string) gardener_api.Seed {
 	return gardener_api.Seed{
 		Spec: gardener_api.SeedSpec{
@@ -370,6 +400,10 @@ func fixConverterConfigForTests() config.Config {
 					EnableIMDSv2: true,
 				},
 			},
+			MachineImage: config.MachineImageConfig{
+				DefaultName:    "gardenlinux",
+				DefaultVersion: "1592.1.0",
+			},
 			Gardener: config.GardenerConfig{
 				ProjectName: "kyma-dev",
 			},
@@ -0,0 +1,178 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	fieldManagerName = "kim"
+
+	// The inventory keeps the list of objects applied in the previous run, so the objects removed from the manifests can be pruned
+	InventoryName      = "kim-bootstrap-inventory"
+	InventoryNamespace = "kube-system"
+	inventoryKey       = "objects"
+)
+
+type ObjectRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func (r ObjectRef) String() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s/%s", r.Kind, r.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+func toObjectRef(obj unstructured.Unstructured) ObjectRef {
+	return ObjectRef{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+type Result struct {
+	Applied []ObjectRef
+	Pruned  []ObjectRef
+}
+
+type Applier struct {
+	client client.Client
+	prune  bool
+}
+
+func NewApplier(shootClient client.Client, prune bool) Applier {
+	return Applier{
+		client: shootClient,
+		prune:  prune,
+	}
+}
+
+// Apply creates or updates the objects with server-side apply.
+// When pruning is enabled, objects applied previously but missing in the current set are deleted.
+func (a Applier) Apply(ctx context.Context, objs []unstructured.Unstructured) (Result, error) {
+	var result Result
+
+	previous, err := a.readInventory(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to read bootstrap inventory: %w", err)
+	}
+
+	for _, obj := range objs {
+		obj.SetManagedFields(nil)
+		obj.SetResourceVersion("")
+
+		if err := a.client.Patch(ctx, &obj, client.Apply, &client.PatchOptions{
+			FieldManager: fieldManagerName,
+			Force:        ptr.To(true),
+		}); err != nil {
+			return result, fmt.Errorf("failed to apply %s: %w", toObjectRef(obj), err)
+		}
+		result.Applied = append(result.Applied, toObjectRef(obj))
+	}
+
+	if a.prune {
+		for _, ref := range previous {
+			if slices.Contains(result.Applied, ref) {
+				continue
+			}
+
+			if err := a.delete(ctx, ref); err != nil {
+				return result, fmt.Errorf("failed to prune %s: %w", ref, err)
+			}
+			result.Pruned = append(result.Pruned, ref)
+		}
+	}
+
+	inventory := result.Applied
+	if !a.prune {
+		// without pruning the inventory keeps all objects ever applied, so they can be pruned once it is enabled
+		for _, ref := range previous {
+			if !slices.Contains(inventory, ref) {
+				inventory = append(inventory, ref)
+			}
+		}
+	}
+
+	if err := a.writeInventory(ctx, inventory); err != nil {
+		return result, fmt.Errorf("failed to write bootstrap inventory: %w", err)
+	}
+
+	return result, nil
+}
+
+func (a Applier) delete(ctx context.Context, ref ObjectRef) error {
+	var obj unstructured.Unstructured
+	obj.SetAPIVersion(ref.APIVersion)
+	obj.SetKind(ref.Kind)
+	obj.SetNamespace(ref.Namespace)
+	obj.SetName(ref.Name)
+
+	return client.IgnoreNotFound(a.client.Delete(ctx, &obj))
+}
+
+func (a Applier) readInventory(ctx context.Context) ([]ObjectRef, error) {
+	var cm corev1.ConfigMap
+	err := a.client.Get(ctx, types.NamespacedName{Name: InventoryName, Namespace: InventoryNamespace}, &cm)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []ObjectRef
+	if data, found := cm.Data[inventoryKey]; found {
+		if err := json.Unmarshal([]byte(data), &refs); err != nil {
+			return nil, err
+		}
+	}
+
+	return refs, nil
+}
+
+func (a Applier) writeInventory(ctx context.Context, refs []ObjectRef) error {
+	data, err := json.Marshal(refs)
+	if err != nil {
+		return err
+	}
+
+	cm := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InventoryName,
+			Namespace: InventoryNamespace,
+			Labels: map[string]string{
+				imv1.LabelReconcilerManagedBy: imv1.LabelValueInfrastructureManager,
+			},
+		},
+		Data: map[string]string{
+			inventoryKey: string(data),
+		},
+	}
+
+	return a.client.Patch(ctx, &cm, client.Apply, &client.PatchOptions{
+		FieldManager: fieldManagerName,
+		Force:        ptr.To(true),
+	})
+}
@@ -0,0 +1,126 @@
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestApplier(t *testing.T) {
+	first := fixConfigMap("first")
+	second := fixConfigMap("second")
+
+	t.Run("Should apply objects and store inventory", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		shootClient := newFakeShootClient()
+
+		// when
+		result, err := NewApplier(shootClient, true).Apply(ctx, []unstructured.Unstructured{first, second})
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, result.Applied, 2)
+		assert.Empty(t, result.Pruned)
+		assertConfigMapExists(t, shootClient, "first", true)
+		assertConfigMapExists(t, shootClient, "second", true)
+
+		inventory, err := NewApplier(shootClient, true).readInventory(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, result.Applied, inventory)
+	})
+
+	t.Run("Should prune objects removed from manifests", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		shootClient := newFakeShootClient()
+		_, err := NewApplier(shootClient, true).Apply(ctx, []unstructured.Unstructured{first, second})
+		require.NoError(t, err)
+
+		// when
+		result, err := NewApplier(shootClient, true).Apply(ctx, []unstructured.Unstructured{first})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []ObjectRef{toObjectRef(second)}, result.Pruned)
+		assertConfigMapExists(t, shootClient, "first", true)
+		assertConfigMapExists(t, shootClient, "second", false)
+	})
+
+	t.Run("Should keep objects removed from manifests when prune is disabled", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		shootClient := newFakeShootClient()
+		_, err := NewApplier(shootClient, false).Apply(ctx, []unstructured.Unstructured{first, second})
+		require.NoError(t, err)
+
+		// when
+		result, err := NewApplier(shootClient, false).Apply(ctx, []unstructured.Unstructured{first})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, result.Pruned)
+		assertConfigMapExists(t, shootClient, "second", true)
+
+		inventory, err := NewApplier(shootClient, false).readInventory(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []ObjectRef{toObjectRef(first), toObjectRef(second)}, inventory)
+	})
+}
+
+func fixConfigMap(name string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(name)
+	obj.SetNamespace("default")
+	return obj
+}
+
+func assertConfigMapExists(t *testing.T, c client.Client, name string, expected bool) {
+	var cm corev1.ConfigMap
+	err := c.Get(context.Background(), types.NamespacedName{Name: name, Namespace: "default"}, &cm)
+	if expected {
+		assert.NoError(t, err)
+	} else {
+		assert.True(t, k8serrors.IsNotFound(err))
+	}
+}
+
+// fake client does not support server-side apply, it is simulated with create or update
+func newFakeShootClient() client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if patch.Type() != types.ApplyPatchType {
+					return c.Patch(ctx, obj, patch, opts...)
+				}
+
+				existing := &unstructured.Unstructured{}
+				existing.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+				err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+				if k8serrors.IsNotFound(err) {
+					return c.Create(ctx, obj)
+				}
+				if err != nil {
+					return err
+				}
+				obj.SetResourceVersion(existing.GetResourceVersion())
+				return c.Update(ctx, obj)
+			},
+		}).Build()
+}
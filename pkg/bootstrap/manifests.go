@@ -0,0 +1,156 @@
+package bootstrap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const BootstrapLabel = "infrastructuremanager.kyma-project.io/bootstrap"
+
+// Manifest is a single bootstrap file; it may contain multiple YAML documents
+type Manifest struct {
+	Name     string
+	template *template.Template
+}
+
+type Manifests []Manifest
+
+// TemplateData is the data available in the bootstrap manifest templates
+type TemplateData struct {
+	Name           string
+	Namespace      string
+	Labels         map[string]string
+	ShootName      string
+	Region         string
+	PlatformRegion string
+	Provider       string
+	Purpose        string
+}
+
+func NewTemplateData(runtime imv1.Runtime) TemplateData {
+	return TemplateData{
+		Name:           runtime.Name,
+		Namespace:      runtime.Namespace,
+		Labels:         runtime.Labels,
+		ShootName:      runtime.Spec.Shoot.Name,
+		Region:         runtime.Spec.Shoot.Region,
+		PlatformRegion: runtime.Spec.Shoot.PlatformRegion,
+		Provider:       runtime.Spec.Shoot.Provider.Type,
+		Purpose:        string(runtime.Spec.Shoot.Purpose),
+	}
+}
+
+func Parse(name, content string) (Manifest, error) {
+	tpl, err := template.New(name).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to parse bootstrap manifest %s: %w", name, err)
+	}
+
+	return Manifest{Name: name, template: tpl}, nil
+}
+
+// LoadFromDir reads all YAML and JSON files from the directory (e.g. a mounted ConfigMap).
+// Files are sorted by name, which determines the order in which the objects are applied.
+func LoadFromDir(dir string) (Manifests, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests Manifests
+	for _, entry := range entries {
+		// ConfigMap volumes contain hidden directories and symlinks (..data), only regular file names are taken
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		if !slices.Contains([]string{".yaml", ".yml", ".json"}, filepath.Ext(entry.Name())) {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		manifest, err := Parse(entry.Name(), string(content))
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+
+	slices.SortFunc(manifests, func(a, b Manifest) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return manifests, nil
+}
+
+// Render executes the templates for the given Runtime and decodes the resulting objects.
+// Every rendered object is labeled as managed by KIM.
+func (m Manifests) Render(runtime imv1.Runtime) ([]unstructured.Unstructured, error) {
+	data := NewTemplateData(runtime)
+
+	var objs []unstructured.Unstructured
+	for _, manifest := range m {
+		var buffer bytes.Buffer
+		if err := manifest.template.Execute(&buffer, data); err != nil {
+			return nil, fmt.Errorf("failed to render bootstrap manifest %s: %w", manifest.Name, err)
+		}
+
+		decoded, err := decode(&buffer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode bootstrap manifest %s: %w", manifest.Name, err)
+		}
+		objs = append(objs, decoded...)
+	}
+
+	return objs, nil
+}
+
+func decode(r io.Reader) ([]unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
+
+	var objs []unstructured.Unstructured
+	for {
+		var obj unstructured.Unstructured
+		err := decoder.Decode(&obj.Object)
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// empty documents, e.g. created by templates with false conditions
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		if obj.GetKind() == "" || obj.GetAPIVersion() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("object must have apiVersion, kind and metadata.name set")
+		}
+
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[imv1.LabelReconcilerManagedBy] = imv1.LabelValueInfrastructureManager
+		labels[BootstrapLabel] = "true"
+		obj.SetLabels(labels)
+
+		objs = append(objs, obj)
+	}
+}
@@ -0,0 +1,154 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: kyma-system
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kyma-runtime-info
+  namespace: kube-system
+  labels:
+    app: runtime-info
+data:
+  runtimeID: {{ index .Labels "kyma-project.io/runtime-id" }}
+  region: {{ .Region }}
+{{- if eq .Provider "aws" }}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: aws-only
+  namespace: kube-system
+{{- end }}
+`
+
+func TestRender(t *testing.T) {
+	t.Run("Should render templates with Runtime data", func(t *testing.T) {
+		// given
+		manifest, err := Parse("test.yaml", testManifest)
+		require.NoError(t, err)
+
+		// when
+		objs, err := Manifests{manifest}.Render(fixRuntime("gcp"))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, objs, 2)
+
+		assert.Equal(t, "Namespace", objs[0].GetKind())
+		assert.Equal(t, "kyma-system", objs[0].GetName())
+
+		assert.Equal(t, "kyma-runtime-info", objs[1].GetName())
+		assert.Equal(t, "kube-system", objs[1].GetNamespace())
+		assert.Equal(t, map[string]string{
+			"app":                         "runtime-info",
+			imv1.LabelReconcilerManagedBy: imv1.LabelValueInfrastructureManager,
+			BootstrapLabel:                "true",
+		}, objs[1].GetLabels())
+
+		data := objs[1].Object["data"].(map[string]interface{})
+		assert.Equal(t, "runtime-id", data["runtimeID"])
+		assert.Equal(t, "europe-west3", data["region"])
+	})
+
+	t.Run("Should render conditional documents", func(t *testing.T) {
+		// given
+		manifest, err := Parse("test.yaml", testManifest)
+		require.NoError(t, err)
+
+		// when
+		objs, err := Manifests{manifest}.Render(fixRuntime("aws"))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, objs, 3)
+		assert.Equal(t, "aws-only", objs[2].GetName())
+	})
+
+	t.Run("Should return error for unknown template field", func(t *testing.T) {
+		// given
+		manifest, err := Parse("test.yaml", "name: {{ .Unknown }}")
+		require.NoError(t, err)
+
+		// when
+		_, err = Manifests{manifest}.Render(fixRuntime("aws"))
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error for object without kind", func(t *testing.T) {
+		// given
+		manifest, err := Parse("test.yaml", "apiVersion: v1\nmetadata:\n  name: test\n")
+		require.NoError(t, err)
+
+		// when
+		_, err = Manifests{manifest}.Render(fixRuntime("aws"))
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestLoadFromDir(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"20-configmap.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: second\n  namespace: default\n",
+		"10-namespace.yml":  "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: first\n",
+		"README.md":         "not a manifest",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0700))
+
+	// when
+	manifests, err := LoadFromDir(dir)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	assert.Equal(t, "10-namespace.yml", manifests[0].Name)
+	assert.Equal(t, "20-configmap.yaml", manifests[1].Name)
+
+	objs, err := manifests.Render(fixRuntime("aws"))
+	require.NoError(t, err)
+	require.Len(t, objs, 2)
+	assert.Equal(t, "first", objs[0].GetName())
+	assert.Equal(t, "second", objs[1].GetName())
+}
+
+func fixRuntime(provider string) imv1.Runtime {
+	return imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime",
+			Namespace: "kcp-system",
+			Labels: map[string]string{
+				imv1.LabelKymaRuntimeID: "runtime-id",
+			},
+		},
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:   "shoot",
+				Region: "europe-west3",
+				Provider: imv1.Provider{
+					Type: provider,
+				},
+			},
+		},
+	}
+}
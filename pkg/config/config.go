@@ -2,7 +2,11 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"slices"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 )
 
 type Config struct {
@@ -15,13 +19,28 @@ type ClusterConfig struct {
 }
 
 type ProviderConfig struct {
-	AWS AWSConfig `json:"aws"`
+	AWS       AWSConfig       `json:"aws"`
+	OpenStack OpenStackConfig `json:"openstack"`
+	// WorkerConfigTemplates are the worker providerConfig templates of the providers, the keys are the provider types
+	WorkerConfigTemplates map[string]json.RawMessage `json:"workerConfigTemplates"`
 }
 
 type AWSConfig struct {
 	EnableIMDSv2 bool `json:"enableIMDSv2"`
 }
 
+// OpenStackConfig contains the settings used in all regions, the settings from Regions take precedence
+type OpenStackConfig struct {
+	OpenStackSettings `json:",inline"`
+	Regions           map[string]OpenStackSettings `json:"regions"`
+}
+
+type OpenStackSettings struct {
+	FloatingPoolName     string `json:"floatingPoolName,omitempty"`
+	LoadBalancerProvider string `json:"loadBalancerProvider,omitempty"`
+	ExposureClassName    string `json:"exposureClassName,omitempty"`
+}
+
 type DNSConfig struct {
 	SecretName   string `json:"secretName" validate:"required"`
 	DomainPrefix string `json:"domainPrefix" validate:"required"`
@@ -33,6 +52,22 @@ type KubernetesConfig struct {
 	EnableKubernetesVersionAutoUpdate   bool         `json:"enableKubernetesVersionAutoUpdate"`
 	EnableMachineImageVersionAutoUpdate bool         `json:"enableMachineImageVersionVersionAutoUpdate"`
 	DefaultOperatorOidc                 OidcProvider `json:"defaultOperatorOidc" validate:"required"`
+	UpgradeInMaintenanceWindow          bool         `json:"upgradeInMaintenanceWindow"`
+	// Components contain the defaults of the cluster autoscaler, vertical pod autoscaler and kube-proxy settings of the Runtime
+	Components KubernetesComponentsConfig `json:"components"`
+}
+
+// KubernetesComponentsConfig contains the settings used for all plans, the settings from Plans take precedence
+type KubernetesComponentsConfig struct {
+	KubernetesComponentsSettings `json:",inline"`
+	// Plans are the settings of the broker plans, the keys are the plan names
+	Plans map[string]KubernetesComponentsSettings `json:"plans"`
+}
+
+type KubernetesComponentsSettings struct {
+	ClusterAutoscaler     *imv1.ClusterAutoscaler     `json:"clusterAutoscaler,omitempty"`
+	VerticalPodAutoscaler *imv1.VerticalPodAutoscaler `json:"verticalPodAutoscaler,omitempty"`
+	KubeProxy             *imv1.KubeProxy             `json:"kubeProxy,omitempty"`
 }
 
 type OidcProvider struct {
@@ -58,6 +93,34 @@ type MachineImageConfig struct {
 	DefaultVersion string `json:"defaultVersion" validate:"required"`
 }
 
+// CloudProfileConfig selects the Gardener cloud profile for the shoots.
+// The first matching override is used, then the default for the provider, then the name built into the provider.
+type CloudProfileConfig struct {
+	Defaults  map[string]string      `json:"defaults"`
+	Overrides []CloudProfileOverride `json:"overrides" validate:"dive"`
+}
+
+// CloudProfileOverride matches runtimes by the shoot region and/or the broker plan name label
+type CloudProfileOverride struct {
+	Provider string `json:"provider" validate:"required"`
+	Region   string `json:"region" validate:"required_without=Plan"`
+	Plan     string `json:"plan" validate:"required_without=Region"`
+	Name     string `json:"name" validate:"required"`
+}
+
+// ShootRule adds annotations and tolerations to the shoots created in the listed platform regions or regions
+type ShootRule struct {
+	PlatformRegions []string          `json:"platformRegions" validate:"required_without=Regions"`
+	Regions         []string          `json:"regions" validate:"required_without=PlatformRegions"`
+	Annotations     map[string]string `json:"annotations" validate:"required_without=Tolerations"`
+	Tolerations     []Toleration      `json:"tolerations" validate:"required_without=Annotations,dive"`
+}
+
+type Toleration struct {
+	Key   string  `json:"key" validate:"required"`
+	Value *string `json:"value,omitempty"`
+}
+
 type ConverterConfig struct {
 	Kubernetes   KubernetesConfig   `json:"kubernetes" validate:"required"`
 	DNS          DNSConfig          `json:"dns" validate:"required"`
@@ -65,6 +128,31 @@ type ConverterConfig struct {
 	MachineImage MachineImageConfig `json:"machineImage" validate:"required"`
 	Gardener     GardenerConfig     `json:"gardener" validate:"required"`
 	AuditLog     AuditLogConfig     `json:"auditLogging" validate:"required"`
+	CloudProfile CloudProfileConfig `json:"cloudProfile"`
+	// ShootRules are replaced by DefaultShootRules if not set, an empty list disables the rules
+	ShootRules   []ShootRule        `json:"shootRules" validate:"dive"`
+	APIServerACL APIServerACLConfig `json:"apiServerACL"`
+	// Extensions are the Gardener extensions of the shoots, the built-in extensions not listed are added before them with their default settings
+	Extensions []ExtensionConfig `json:"extensions" validate:"dive"`
+}
+
+type APIServerACLConfig struct {
+	// KCPEgressCIDRs are always allowed in the API server ACL, so the infrastructure manager can reach the API servers of the runtimes
+	KCPEgressCIDRs []string `json:"kcpEgressCIDRs"`
+}
+
+// ExtensionConfig declares a Gardener extension of the shoots.
+// The providerConfig of the built-in extensions is created by their handlers, the other extensions use the configured one.
+type ExtensionConfig struct {
+	Type           string          `json:"type" validate:"required"`
+	ProviderConfig json.RawMessage `json:"providerConfig,omitempty"`
+	// Create adds the extension to the new shoots
+	Create bool `json:"create"`
+	// Patch adds or updates the extension on the existing shoots
+	Patch bool `json:"patch"`
+	// Plans and Providers enable the extension only for the listed broker plans and provider types, all runtimes are matched if empty
+	Plans     []string `json:"plans"`
+	Providers []string `json:"providers"`
 }
 
 type ReaderGetter = func() (io.Reader, error)
@@ -76,3 +164,202 @@ func (c *Config) Load(f ReaderGetter) error {
 	}
 	return json.NewDecoder(r).Decode(c)
 }
+
+// DefaultShootRules contains the EU access regions and the toleration required in the KSA region
+func DefaultShootRules() []ShootRule {
+	return []ShootRule{
+		{
+			PlatformRegions: []string{"cf-eu11", "cf-ch20"},
+			Annotations: map[string]string{
+				"support.gardener.cloud/eu-access-for-cluster-nodes": "true",
+			},
+		},
+		{
+			Regions:     []string{"me-central2"},
+			Tolerations: []Toleration{{Key: "ksa-assured-workload"}},
+		},
+	}
+}
+
+func (c ConverterConfig) EffectiveShootRules() []ShootRule {
+	if c.ShootRules == nil {
+		return DefaultShootRules()
+	}
+	return c.ShootRules
+}
+
+// Matches checks if the rule applies to the shoot in the platform region or region
+func (r ShootRule) Matches(platformRegion, region string) bool {
+	return (platformRegion != "" && slices.Contains(r.PlatformRegions, platformRegion)) ||
+		(region != "" && slices.Contains(r.Regions, region))
+}
+
+// CloudProfileName returns the cloud profile configured for the provider, region and plan, empty if none is configured
+func (c CloudProfileConfig) CloudProfileName(provider, region, plan string) string {
+	for _, override := range c.Overrides {
+		if override.Provider != provider {
+			continue
+		}
+		if override.Region != "" && override.Region != region {
+			continue
+		}
+		if override.Plan != "" && override.Plan != plan {
+			continue
+		}
+		return override.Name
+	}
+
+	return c.Defaults[provider]
+}
+
+// Validate checks if the cloud profiles are configured only for the supported providers.
+// The fields of the overrides are validated with the struct tags.
+func (c CloudProfileConfig) Validate(providers []string) error {
+	for provider, name := range c.Defaults {
+		if !slices.Contains(providers, provider) {
+			return fmt.Errorf("cloud profile configured for unsupported provider: %s", provider)
+		}
+		if name == "" {
+			return fmt.Errorf("empty default cloud profile name for provider: %s", provider)
+		}
+	}
+
+	for i, override := range c.Overrides {
+		if !slices.Contains(providers, override.Provider) {
+			return fmt.Errorf("cloud profile override %d configured for unsupported provider: %s", i, override.Provider)
+		}
+	}
+
+	return nil
+}
+
+// ValidateWorkerConfigTemplates checks if the templates are configured only for the supported providers.
+// The templates are used as the worker providerConfig, so they must be JSON objects with the apiVersion and kind.
+func (c ProviderConfig) ValidateWorkerConfigTemplates(providers []string) error {
+	for provider, template := range c.WorkerConfigTemplates {
+		if !slices.Contains(providers, provider) {
+			return fmt.Errorf("worker config template configured for unsupported provider: %s", provider)
+		}
+
+		var typeMeta struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		}
+		if err := json.Unmarshal(template, &typeMeta); err != nil {
+			return fmt.Errorf("invalid worker config template for provider %s: %w", provider, err)
+		}
+		if typeMeta.APIVersion == "" || typeMeta.Kind == "" {
+			return fmt.Errorf("worker config template for provider %s must have the apiVersion and kind", provider)
+		}
+	}
+
+	return nil
+}
+
+// Matches checks if the extension is enabled for the broker plan and provider type
+func (e ExtensionConfig) Matches(plan, provider string) bool {
+	return (len(e.Plans) == 0 || slices.Contains(e.Plans, plan)) &&
+		(len(e.Providers) == 0 || slices.Contains(e.Providers, provider))
+}
+
+// ValidateExtensions checks if every extension is configured once and the providerConfig is not set for the built-in extensions
+func (c ConverterConfig) ValidateExtensions(builtInTypes []string) error {
+	types := make(map[string]bool, len(c.Extensions))
+	for _, extension := range c.Extensions {
+		if types[extension.Type] {
+			return fmt.Errorf("extension configured more than once: %s", extension.Type)
+		}
+		types[extension.Type] = true
+
+		if slices.Contains(builtInTypes, extension.Type) && len(extension.ProviderConfig) > 0 {
+			return fmt.Errorf("providerConfig cannot be configured for the built-in extension: %s", extension.Type)
+		}
+	}
+
+	return nil
+}
+
+// ForRegion returns the OpenStack settings for the region, the settings not set for the region are taken from the defaults
+func (c OpenStackConfig) ForRegion(region string) OpenStackSettings {
+	return c.OpenStackSettings.Merge(c.Regions[region])
+}
+
+// Merge returns the settings with the non-empty fields replaced by the ones from the override
+func (s OpenStackSettings) Merge(override OpenStackSettings) OpenStackSettings {
+	if override.FloatingPoolName != "" {
+		s.FloatingPoolName = override.FloatingPoolName
+	}
+	if override.LoadBalancerProvider != "" {
+		s.LoadBalancerProvider = override.LoadBalancerProvider
+	}
+	if override.ExposureClassName != "" {
+		s.ExposureClassName = override.ExposureClassName
+	}
+	return s
+}
+
+// ForPlan returns the Kubernetes components settings for the plan, the settings not set for the plan are taken from the defaults
+func (c KubernetesComponentsConfig) ForPlan(plan string) KubernetesComponentsSettings {
+	return c.KubernetesComponentsSettings.Merge(c.Plans[plan])
+}
+
+// Merge returns the settings with the fields set in the override replaced
+func (s KubernetesComponentsSettings) Merge(override KubernetesComponentsSettings) KubernetesComponentsSettings {
+	return KubernetesComponentsSettings{
+		ClusterAutoscaler:     mergeClusterAutoscaler(s.ClusterAutoscaler, override.ClusterAutoscaler),
+		VerticalPodAutoscaler: mergeVerticalPodAutoscaler(s.VerticalPodAutoscaler, override.VerticalPodAutoscaler),
+		KubeProxy:             mergeKubeProxy(s.KubeProxy, override.KubeProxy),
+	}
+}
+
+func mergeClusterAutoscaler(base, override *imv1.ClusterAutoscaler) *imv1.ClusterAutoscaler {
+	if override == nil {
+		return base.DeepCopy()
+	}
+	if base == nil {
+		return override.DeepCopy()
+	}
+
+	merged := base.DeepCopy()
+	mergeField(&merged.Expander, override.Expander)
+	mergeField(&merged.ScaleDownDelayAfterAdd, override.ScaleDownDelayAfterAdd)
+	mergeField(&merged.ScaleDownDelayAfterDelete, override.ScaleDownDelayAfterDelete)
+	mergeField(&merged.ScaleDownDelayAfterFailure, override.ScaleDownDelayAfterFailure)
+	mergeField(&merged.ScaleDownUnneededTime, override.ScaleDownUnneededTime)
+	mergeField(&merged.ScaleDownUtilizationThreshold, override.ScaleDownUtilizationThreshold)
+	mergeField(&merged.MaxNodeProvisionTime, override.MaxNodeProvisionTime)
+	return merged
+}
+
+func mergeVerticalPodAutoscaler(base, override *imv1.VerticalPodAutoscaler) *imv1.VerticalPodAutoscaler {
+	if override == nil {
+		return base.DeepCopy()
+	}
+	if base == nil {
+		return override.DeepCopy()
+	}
+
+	merged := base.DeepCopy()
+	mergeField(&merged.Enabled, override.Enabled)
+	return merged
+}
+
+func mergeKubeProxy(base, override *imv1.KubeProxy) *imv1.KubeProxy {
+	if override == nil {
+		return base.DeepCopy()
+	}
+	if base == nil {
+		return override.DeepCopy()
+	}
+
+	merged := base.DeepCopy()
+	mergeField(&merged.Mode, override.Mode)
+	return merged
+}
+
+func mergeField[T any](field **T, override *T) {
+	if override != nil {
+		value := *override
+		*field = &value
+	}
+}
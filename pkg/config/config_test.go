@@ -0,0 +1,184 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-playground/validator/v10"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCloudProfileConfig(t *testing.T) {
+	cloudProfileConfig := CloudProfileConfig{
+		Defaults: map[string]string{
+			"aws": "aws-default",
+		},
+		Overrides: []CloudProfileOverride{
+			{Provider: "aws", Region: "eu-central-2", Plan: "aws", Name: "aws-sovereign"},
+			{Provider: "aws", Region: "eu-central-2", Name: "aws-region"},
+		},
+	}
+
+	t.Run("Should select cloud profile name", func(t *testing.T) {
+		assert.Equal(t, "aws-sovereign", cloudProfileConfig.CloudProfileName("aws", "eu-central-2", "aws"))
+		assert.Equal(t, "aws-region", cloudProfileConfig.CloudProfileName("aws", "eu-central-2", "trial"))
+		assert.Equal(t, "aws-default", cloudProfileConfig.CloudProfileName("aws", "eu-central-1", "aws"))
+		assert.Equal(t, "", cloudProfileConfig.CloudProfileName("gcp", "eu-central-2", "aws"))
+	})
+
+	t.Run("Should validate configured providers", func(t *testing.T) {
+		require.NoError(t, cloudProfileConfig.Validate([]string{"aws", "gcp"}))
+		require.EqualError(t, cloudProfileConfig.Validate([]string{"gcp"}), "cloud profile configured for unsupported provider: aws")
+
+		withEmptyDefault := CloudProfileConfig{Defaults: map[string]string{"aws": ""}}
+		require.EqualError(t, withEmptyDefault.Validate([]string{"aws"}), "empty default cloud profile name for provider: aws")
+	})
+
+	t.Run("Should require region or plan in overrides", func(t *testing.T) {
+		validate := validator.New(validator.WithRequiredStructEnabled())
+
+		require.NoError(t, validate.Struct(cloudProfileConfig))
+		require.Error(t, validate.Struct(CloudProfileConfig{
+			Overrides: []CloudProfileOverride{{Provider: "aws", Name: "aws-any"}},
+		}))
+	})
+}
+
+func TestShootRules(t *testing.T) {
+	t.Run("Should use default rules if not configured", func(t *testing.T) {
+		assert.Equal(t, DefaultShootRules(), ConverterConfig{}.EffectiveShootRules())
+		assert.Empty(t, ConverterConfig{ShootRules: []ShootRule{}}.EffectiveShootRules())
+	})
+
+	t.Run("Should match platform region or region", func(t *testing.T) {
+		rule := ShootRule{PlatformRegions: []string{"cf-eu11"}, Regions: []string{"me-central2"}}
+
+		assert.True(t, rule.Matches("cf-eu11", "eu-central-1"))
+		assert.True(t, rule.Matches("", "me-central2"))
+		assert.False(t, rule.Matches("cf-eu10", "eu-central-1"))
+		assert.False(t, ShootRule{}.Matches("", ""))
+	})
+
+	t.Run("Should require region and annotations or tolerations in rules", func(t *testing.T) {
+		validate := validator.New(validator.WithRequiredStructEnabled())
+
+		for _, rule := range DefaultShootRules() {
+			require.NoError(t, validate.Struct(rule))
+		}
+		require.Error(t, validate.Struct(ShootRule{Annotations: map[string]string{"key": "value"}}), "no region")
+		require.Error(t, validate.Struct(ShootRule{Regions: []string{"me-central2"}}), "no annotations and tolerations")
+		require.Error(t, validate.Struct(ShootRule{Regions: []string{"me-central2"}, Tolerations: []Toleration{{}}}), "no toleration key")
+	})
+}
+
+func TestWorkerConfigTemplates(t *testing.T) {
+	t.Run("Should validate configured providers and templates", func(t *testing.T) {
+		providerConfig := ProviderConfig{
+			WorkerConfigTemplates: map[string]json.RawMessage{
+				"openstack": json.RawMessage(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serverGroup":{"policy":"soft-anti-affinity"}}`),
+			},
+		}
+
+		require.NoError(t, providerConfig.ValidateWorkerConfigTemplates([]string{"aws", "openstack"}))
+		require.EqualError(t, providerConfig.ValidateWorkerConfigTemplates([]string{"aws"}), "worker config template configured for unsupported provider: openstack")
+	})
+
+	t.Run("Should require the apiVersion and kind", func(t *testing.T) {
+		withoutKind := ProviderConfig{
+			WorkerConfigTemplates: map[string]json.RawMessage{
+				"aws": json.RawMessage(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1"}`),
+			},
+		}
+		require.EqualError(t, withoutKind.ValidateWorkerConfigTemplates([]string{"aws"}), "worker config template for provider aws must have the apiVersion and kind")
+
+		notObject := ProviderConfig{
+			WorkerConfigTemplates: map[string]json.RawMessage{
+				"aws": json.RawMessage(`[]`),
+			},
+		}
+		require.ErrorContains(t, notObject.ValidateWorkerConfigTemplates([]string{"aws"}), "invalid worker config template for provider aws")
+	})
+}
+
+func TestExtensionsConfig(t *testing.T) {
+	t.Run("Should match the extension for the plans and providers", func(t *testing.T) {
+		extension := ExtensionConfig{Type: "shoot-lakom-service", Plans: []string{"aws", "gcp"}, Providers: []string{"aws"}}
+
+		assert.True(t, extension.Matches("aws", "aws"))
+		assert.False(t, extension.Matches("gcp", "gcp"))
+		assert.False(t, extension.Matches("trial", "aws"))
+		assert.True(t, ExtensionConfig{Type: "shoot-lakom-service"}.Matches("trial", "aws"))
+	})
+
+	t.Run("Should reject duplicated extensions", func(t *testing.T) {
+		converterConfig := ConverterConfig{
+			Extensions: []ExtensionConfig{{Type: "shoot-lakom-service", Create: true}, {Type: "shoot-lakom-service", Patch: true}},
+		}
+
+		require.EqualError(t, converterConfig.ValidateExtensions(nil), "extension configured more than once: shoot-lakom-service")
+	})
+
+	t.Run("Should reject providerConfig of the built-in extensions", func(t *testing.T) {
+		converterConfig := ConverterConfig{
+			Extensions: []ExtensionConfig{
+				{Type: "shoot-cert-service", Create: true},
+				{Type: "registry-cache", Create: true, ProviderConfig: json.RawMessage(`{"apiVersion":"registry.extensions.gardener.cloud/v1alpha3","kind":"RegistryConfig"}`)},
+			},
+		}
+		require.NoError(t, converterConfig.ValidateExtensions([]string{"shoot-cert-service"}))
+
+		converterConfig.Extensions[0].ProviderConfig = json.RawMessage(`{}`)
+		require.EqualError(t, converterConfig.ValidateExtensions([]string{"shoot-cert-service"}), "providerConfig cannot be configured for the built-in extension: shoot-cert-service")
+	})
+}
+
+func TestKubernetesComponentsConfig(t *testing.T) {
+	componentsConfig := KubernetesComponentsConfig{
+		KubernetesComponentsSettings: KubernetesComponentsSettings{
+			ClusterAutoscaler: &imv1.ClusterAutoscaler{
+				Expander:              ptr.To(gardener.ClusterAutoscalerExpanderLeastWaste),
+				ScaleDownUnneededTime: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+			KubeProxy: &imv1.KubeProxy{Mode: ptr.To(gardener.ProxyModeIPTables)},
+		},
+		Plans: map[string]KubernetesComponentsSettings{
+			"trial": {
+				ClusterAutoscaler: &imv1.ClusterAutoscaler{
+					ScaleDownUnneededTime: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+				VerticalPodAutoscaler: &imv1.VerticalPodAutoscaler{Enabled: ptr.To(false)},
+			},
+		},
+	}
+
+	t.Run("Should merge the plan settings with the defaults", func(t *testing.T) {
+		assert.Equal(t, KubernetesComponentsSettings{
+			ClusterAutoscaler: &imv1.ClusterAutoscaler{
+				Expander:              ptr.To(gardener.ClusterAutoscalerExpanderLeastWaste),
+				ScaleDownUnneededTime: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			VerticalPodAutoscaler: &imv1.VerticalPodAutoscaler{Enabled: ptr.To(false)},
+			KubeProxy:             &imv1.KubeProxy{Mode: ptr.To(gardener.ProxyModeIPTables)},
+		}, componentsConfig.ForPlan("trial"))
+	})
+
+	t.Run("Should use the defaults for the plan without settings", func(t *testing.T) {
+		assert.Equal(t, componentsConfig.KubernetesComponentsSettings, componentsConfig.ForPlan("aws"))
+	})
+
+	t.Run("Should not modify the defaults when merging", func(t *testing.T) {
+		// when
+		merged := componentsConfig.ForPlan("trial")
+		*merged.KubeProxy.Mode = gardener.ProxyModeIPVS
+
+		// then
+		assert.Equal(t, gardener.ProxyModeIPTables, *componentsConfig.KubeProxy.Mode)
+		assert.Equal(t, 30*time.Minute, componentsConfig.ClusterAutoscaler.ScaleDownUnneededTime.Duration)
+	})
+}
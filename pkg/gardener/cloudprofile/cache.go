@@ -0,0 +1,61 @@
+package cloudprofile
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Cache keeps the CloudProfiles read from the Gardener cluster, a CloudProfile is read again after the TTL expires.
+// The CloudProfiles change rarely and are large, the cache avoids reading them in every reconciliation.
+type Cache struct {
+	gardenClient client.Client
+	ttl          time.Duration
+	now          func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	cloudProfile *gardener.CloudProfile
+	expiresAt    time.Time
+}
+
+func NewCache(gardenClient client.Client, ttl time.Duration) *Cache {
+	return &Cache{
+		gardenClient: gardenClient,
+		ttl:          ttl,
+		now:          time.Now,
+		entries:      map[string]cacheEntry{},
+	}
+}
+
+// Getter returns a Getter reading the CloudProfiles through the cache, the returned CloudProfiles must not be modified
+func (c *Cache) Getter(ctx context.Context) Getter {
+	getCloudProfile := NewGetter(ctx, c.gardenClient)
+
+	return func(name string) (*gardener.CloudProfile, error) {
+		c.mu.Lock()
+		entry, found := c.entries[name]
+		c.mu.Unlock()
+
+		if found && c.now().Before(entry.expiresAt) {
+			return entry.cloudProfile, nil
+		}
+
+		cloudProfile, err := getCloudProfile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.entries[name] = cacheEntry{cloudProfile: cloudProfile, expiresAt: c.now().Add(c.ttl)}
+		c.mu.Unlock()
+
+		return cloudProfile, nil
+	}
+}
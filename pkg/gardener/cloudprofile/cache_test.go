@@ -0,0 +1,70 @@
+package cloudprofile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestCache(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, gardener.AddToScheme(scheme))
+
+	var reads int
+	gardenClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(fixCloudProfile("aws")).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				reads++
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(gardenClient, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	getCloudProfile := cache.Getter(context.Background())
+
+	t.Run("Should read the cloud profile once until the TTL expires", func(t *testing.T) {
+		// when
+		first, err := getCloudProfile("aws")
+		require.NoError(t, err)
+		second, err := cache.Getter(context.Background())("aws")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, "aws", first.Name)
+		assert.Same(t, first, second)
+		assert.Equal(t, 1, reads)
+
+		// when
+		now = now.Add(time.Minute)
+		_, err = getCloudProfile("aws")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, reads)
+	})
+
+	t.Run("Should not cache errors", func(t *testing.T) {
+		// when
+		_, err := getCloudProfile("azure")
+		require.ErrorContains(t, err, "failed to get cloud profile azure")
+		_, err = getCloudProfile("azure")
+
+		// then
+		require.ErrorContains(t, err, "failed to get cloud profile azure")
+		assert.Equal(t, 4, reads)
+	})
+}
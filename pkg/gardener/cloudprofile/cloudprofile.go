@@ -0,0 +1,25 @@
+package cloudprofile
+
+import (
+	"context"
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Getter returns the Gardener CloudProfile with the given name
+type Getter func(name string) (*gardener.CloudProfile, error)
+
+// NewGetter returns a Getter reading the CloudProfiles from the Gardener cluster
+func NewGetter(ctx context.Context, gardenClient client.Client) Getter {
+	return func(name string) (*gardener.CloudProfile, error) {
+		var cloudProfile gardener.CloudProfile
+
+		if err := gardenClient.Get(ctx, client.ObjectKey{Name: name}, &cloudProfile); err != nil {
+			return nil, fmt.Errorf("failed to get cloud profile %s: %w", name, err)
+		}
+
+		return &cloudProfile, nil
+	}
+}
@@ -0,0 +1,60 @@
+package cloudprofile
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewGetter(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, gardener.AddToScheme(scheme))
+
+	gardenClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(fixCloudProfile("aws")).
+		Build()
+
+	getCloudProfile := NewGetter(context.Background(), gardenClient)
+
+	t.Run("Should return the cloud profile", func(t *testing.T) {
+		// when
+		cloudProfile, err := getCloudProfile("aws")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "aws", cloudProfile.Name)
+	})
+
+	t.Run("Should return error for a missing cloud profile", func(t *testing.T) {
+		// when
+		_, err := getCloudProfile("azure")
+
+		// then
+		require.ErrorContains(t, err, "failed to get cloud profile azure")
+	})
+}
+
+func fixCloudProfile(name string) *gardener.CloudProfile {
+	return &gardener.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: gardener.CloudProfileSpec{
+			Regions: []gardener.Region{
+				{
+					Name: "eu-central-1",
+					Zones: []gardener.AvailabilityZone{
+						{Name: "eu-central-1a"},
+						{Name: "eu-central-1b"},
+						{Name: "eu-central-1c"},
+					},
+				},
+			},
+		},
+	}
+}
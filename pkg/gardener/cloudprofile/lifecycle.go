@@ -0,0 +1,88 @@
+package cloudprofile
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+// VersionLifecycle returns the lifecycle of the Kubernetes version and the machine image versions used by the shoot workers.
+// The versions with the expiration date within the warningPeriod are marked to expire soon, none are marked if the warningPeriod is 0.
+func VersionLifecycle(cloudProfile *gardener.CloudProfile, shoot *gardener.Shoot, now time.Time, warningPeriod time.Duration) *imv1.VersionLifecycle {
+	version := shoot.Spec.Kubernetes.Version
+	lifecycle := &imv1.VersionLifecycle{
+		Kubernetes: versionStatus(cloudProfile.Spec.Kubernetes.Versions, version, now, warningPeriod),
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		image := worker.Machine.Image
+		if image == nil || image.Version == nil {
+			continue
+		}
+
+		alreadyAdded := slices.ContainsFunc(lifecycle.MachineImages, func(status imv1.MachineImageVersionStatus) bool {
+			return status.Name == image.Name && status.Version == *image.Version
+		})
+		if alreadyAdded {
+			continue
+		}
+
+		var imageVersions []gardener.ExpirableVersion
+		for _, machineImage := range cloudProfile.Spec.MachineImages {
+			if machineImage.Name != image.Name {
+				continue
+			}
+			for _, imageVersion := range machineImage.Versions {
+				imageVersions = append(imageVersions, imageVersion.ExpirableVersion)
+			}
+		}
+
+		lifecycle.MachineImages = append(lifecycle.MachineImages, imv1.MachineImageVersionStatus{
+			Name:          image.Name,
+			VersionStatus: versionStatus(imageVersions, *image.Version, now, warningPeriod),
+		})
+	}
+
+	slices.SortFunc(lifecycle.MachineImages, func(a, b imv1.MachineImageVersionStatus) int {
+		if a.Name != b.Name {
+			return strings.Compare(a.Name, b.Name)
+		}
+		return strings.Compare(a.Version, b.Version)
+	})
+
+	return lifecycle
+}
+
+func versionStatus(versions []gardener.ExpirableVersion, version string, now time.Time, warningPeriod time.Duration) imv1.VersionStatus {
+	status := imv1.VersionStatus{
+		Version:        version,
+		Classification: imv1.VersionClassificationUnknown,
+	}
+
+	index := slices.IndexFunc(versions, func(v gardener.ExpirableVersion) bool {
+		return v.Version == version
+	})
+	if index < 0 {
+		return status
+	}
+
+	if classification := versions[index].Classification; classification != nil {
+		status.Classification = imv1.VersionClassification(*classification)
+	}
+
+	expirationDate := versions[index].ExpirationDate
+	if expirationDate == nil {
+		return status
+	}
+
+	status.ExpirationDate = expirationDate.DeepCopy()
+	if !now.Before(expirationDate.Time) {
+		status.Classification = imv1.VersionClassificationExpired
+	}
+	status.ExpiresSoon = warningPeriod > 0 && now.Add(warningPeriod).After(expirationDate.Time)
+
+	return status
+}
@@ -0,0 +1,77 @@
+package cloudprofile
+
+import (
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestVersionLifecycle(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	expired := &metav1.Time{Time: now.AddDate(0, -1, 0)}
+	expiring := &metav1.Time{Time: now.AddDate(0, 1, 0)}
+
+	cloudProfile := fixCloudProfileWithVersions(now)
+	cloudProfile.Spec.Kubernetes.Versions[0].Classification = ptr.To(gardener.ClassificationDeprecated)
+	cloudProfile.Spec.MachineImages[0].Versions[1].Classification = ptr.To(gardener.ClassificationSupported)
+
+	t.Run("Should return the lifecycle of the Kubernetes and machine image versions", func(t *testing.T) {
+		// given
+		shoot := fixShoot("1.30.2",
+			fixWorker("main", "m6i.large", "gardenlinux", "1592.1.0", "eu-central-1a"),
+			fixWorker("additional", "m6i.large", "gardenlinux", "1443.3.0", "eu-central-1a"),
+			fixWorker("next", "m6i.large", "gardenlinux", "1592.1.0", "eu-central-1a"),
+			fixWorker("ubuntu", "m6i.large", "ubuntu", "22.04", "eu-central-1a"),
+		)
+
+		// when
+		lifecycle := VersionLifecycle(cloudProfile, shoot, now, 0)
+
+		// then
+		assert.Equal(t, &imv1.VersionLifecycle{
+			Kubernetes: imv1.VersionStatus{Version: "1.30.2", Classification: imv1.VersionClassificationDeprecated, ExpirationDate: expiring},
+			MachineImages: []imv1.MachineImageVersionStatus{
+				{Name: "gardenlinux", VersionStatus: imv1.VersionStatus{Version: "1443.3.0", Classification: imv1.VersionClassificationExpired, ExpirationDate: expired}},
+				{Name: "gardenlinux", VersionStatus: imv1.VersionStatus{Version: "1592.1.0", Classification: imv1.VersionClassificationSupported}},
+				{Name: "ubuntu", VersionStatus: imv1.VersionStatus{Version: "22.04", Classification: imv1.VersionClassificationUnknown}},
+			},
+		}, lifecycle)
+	})
+
+	t.Run("Should mark the versions expiring within the warning period", func(t *testing.T) {
+		// given
+		shoot := fixShoot("1.30.2", fixWorker("main", "m6i.large", "gardenlinux", "1443.3.0", "eu-central-1a"))
+
+		for _, testCase := range []struct {
+			warningPeriod      time.Duration
+			expectedExpireSoon bool
+		}{
+			{warningPeriod: 14 * 24 * time.Hour, expectedExpireSoon: false},
+			{warningPeriod: 45 * 24 * time.Hour, expectedExpireSoon: true},
+		} {
+			// when
+			lifecycle := VersionLifecycle(cloudProfile, shoot, now, testCase.warningPeriod)
+
+			// then
+			assert.Equal(t, testCase.expectedExpireSoon, lifecycle.Kubernetes.ExpiresSoon)
+			assert.True(t, lifecycle.MachineImages[0].ExpiresSoon)
+		}
+	})
+
+	t.Run("Should classify the version without classification as unknown", func(t *testing.T) {
+		// given
+		shoot := fixShoot("1.29.10")
+
+		// when
+		lifecycle := VersionLifecycle(cloudProfile, shoot, now.AddDate(0, -2, 0), 0)
+
+		// then
+		assert.Equal(t, imv1.VersionStatus{Version: "1.29.10", Classification: imv1.VersionClassificationUnknown, ExpirationDate: expired}, lifecycle.Kubernetes)
+		assert.Empty(t, lifecycle.MachineImages)
+	})
+}
@@ -0,0 +1,54 @@
+package cloudprofile
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// NextKubernetesVersion returns the version to which the shoot is upgraded from the currentVersion on the way to the targetVersion.
+// Gardener upgrades the Kubernetes version only by one minor at a time, so if the targetVersion is more minors ahead,
+// the latest not expired and not preview version of the next minor available in the CloudProfile is returned.
+func NextKubernetesVersion(cloudProfile *gardener.CloudProfile, currentVersion, targetVersion string, now time.Time) (string, error) {
+	current, err := semver.NewVersion(currentVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid Kubernetes version %s: %w", currentVersion, err)
+	}
+
+	target, err := semver.NewVersion(targetVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid Kubernetes version %s: %w", targetVersion, err)
+	}
+
+	if target.Major() != current.Major() || target.Minor() <= current.Minor()+1 {
+		return targetVersion, nil
+	}
+
+	var next *semver.Version
+	for _, expirableVersion := range cloudProfile.Spec.Kubernetes.Versions {
+		version, err := semver.NewVersion(expirableVersion.Version)
+		if err != nil || version.Major() != current.Major() || version.Minor() != current.Minor()+1 {
+			continue
+		}
+
+		if expirableVersion.Classification != nil && *expirableVersion.Classification == gardener.ClassificationPreview {
+			continue
+		}
+
+		if expirableVersion.ExpirationDate != nil && !now.Before(expirableVersion.ExpirationDate.Time) {
+			continue
+		}
+
+		if next == nil || version.GreaterThan(next) {
+			next = version
+		}
+	}
+
+	if next == nil {
+		return "", fmt.Errorf("no Kubernetes version %d.%d is available in the cloud profile %s for the upgrade from %s to %s", current.Major(), current.Minor()+1, cloudProfile.Name, currentVersion, targetVersion)
+	}
+
+	return next.Original(), nil
+}
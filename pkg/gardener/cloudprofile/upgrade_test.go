@@ -0,0 +1,91 @@
+package cloudprofile
+
+import (
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestNextKubernetesVersion(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	expired := &metav1.Time{Time: now.AddDate(0, -1, 0)}
+
+	cloudProfile := fixCloudProfile("aws")
+	cloudProfile.Spec.Kubernetes.Versions = []gardener.ExpirableVersion{
+		{Version: "1.32.0", Classification: ptr.To(gardener.ClassificationPreview)},
+		{Version: "1.31.1"},
+		{Version: "1.30.4", Classification: ptr.To(gardener.ClassificationPreview)},
+		{Version: "1.30.3", Classification: ptr.To(gardener.ClassificationSupported)},
+		{Version: "1.30.10", ExpirationDate: expired},
+		{Version: "1.30.2", Classification: ptr.To(gardener.ClassificationDeprecated)},
+		{Version: "1.29.10", ExpirationDate: expired},
+	}
+
+	for _, testCase := range []struct {
+		name            string
+		currentVersion  string
+		targetVersion   string
+		expectedVersion string
+	}{
+		{
+			name:            "Same version",
+			currentVersion:  "1.29.10",
+			targetVersion:   "1.29.10",
+			expectedVersion: "1.29.10",
+		},
+		{
+			name:            "Patch upgrade",
+			currentVersion:  "1.30.2",
+			targetVersion:   "1.30.3",
+			expectedVersion: "1.30.3",
+		},
+		{
+			name:            "Upgrade to the next minor",
+			currentVersion:  "1.30.2",
+			targetVersion:   "1.31.1",
+			expectedVersion: "1.31.1",
+		},
+		{
+			name:            "Upgrade two minors ahead uses the latest usable version of the next minor",
+			currentVersion:  "1.29.10",
+			targetVersion:   "1.31.1",
+			expectedVersion: "1.30.3",
+		},
+		{
+			name:            "Upgrade three minors ahead",
+			currentVersion:  "1.29.10",
+			targetVersion:   "1.32.0",
+			expectedVersion: "1.30.3",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// when
+			version, err := NextKubernetesVersion(cloudProfile, testCase.currentVersion, testCase.targetVersion, now)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedVersion, version)
+		})
+	}
+
+	t.Run("Should return error if no version of the next minor is available", func(t *testing.T) {
+		// when
+		_, err := NextKubernetesVersion(cloudProfile, "1.28.5", "1.30.3", now)
+
+		// then
+		require.EqualError(t, err, "no Kubernetes version 1.29 is available in the cloud profile aws for the upgrade from 1.28.5 to 1.30.3")
+	})
+
+	t.Run("Should return error for invalid version", func(t *testing.T) {
+		// when
+		_, err := NextKubernetesVersion(cloudProfile, "1.29.10", "latest", now)
+
+		// then
+		require.ErrorContains(t, err, "invalid Kubernetes version latest")
+	})
+}
@@ -0,0 +1,161 @@
+package cloudprofile
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const defaultArchitecture = "amd64"
+
+// ValidateShoot checks the Kubernetes version and the machine types and images of the shoot workers against the CloudProfile.
+// The versions must not be expired, the worker zones must be available in the region, and the machine types must be available in the zones of the workers and supported by the machine image architectures.
+// The values already used by the existing shoot are not checked, Gardener keeps them until they are updated. The existingShoot is nil for new shoots.
+// The errors refer to the Runtime fields, the workers are identified by their names.
+func ValidateShoot(cloudProfile *gardener.CloudProfile, shoot, existingShoot *gardener.Shoot, now time.Time) field.ErrorList {
+	var allErrs field.ErrorList
+	shootPath := field.NewPath("spec", "shoot")
+
+	regionIndex := slices.IndexFunc(cloudProfile.Spec.Regions, func(region gardener.Region) bool {
+		return region.Name == shoot.Spec.Region
+	})
+	if regionIndex < 0 {
+		return field.ErrorList{field.Invalid(shootPath.Child("region"), shoot.Spec.Region, fmt.Sprintf("is not available in the cloud profile %s", cloudProfile.Name))}
+	}
+	region := cloudProfile.Spec.Regions[regionIndex]
+
+	version := shoot.Spec.Kubernetes.Version
+	if existingShoot == nil || existingShoot.Spec.Kubernetes.Version != version {
+		if msg := checkVersion(cloudProfile.Spec.Kubernetes.Versions, version, now); msg != "" {
+			allErrs = append(allErrs, field.Invalid(shootPath.Child("kubernetes", "version"), version, fmt.Sprintf("%s in the cloud profile %s", msg, cloudProfile.Name)))
+		}
+	}
+
+	existingWorkers := map[string]gardener.Worker{}
+	if existingShoot != nil {
+		for _, worker := range existingShoot.Spec.Provider.Workers {
+			existingWorkers[worker.Name] = worker
+		}
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		allErrs = append(allErrs, validateWorker(cloudProfile, region, worker, existingWorkers[worker.Name], now, shootPath.Child("provider", "workers").Key(worker.Name))...)
+	}
+
+	return allErrs
+}
+
+func validateWorker(cloudProfile *gardener.CloudProfile, region gardener.Region, worker, existingWorker gardener.Worker, now time.Time, fldPath *field.Path) field.ErrorList {
+	allErrs := validateZones(cloudProfile, region, worker, existingWorker, fldPath.Child("zones"))
+	machinePath := fldPath.Child("machine")
+
+	machineTypeIndex := slices.IndexFunc(cloudProfile.Spec.MachineTypes, func(machineType gardener.MachineType) bool {
+		return machineType.Name == worker.Machine.Type
+	})
+	if worker.Machine.Type != existingWorker.Machine.Type {
+		allErrs = append(allErrs, validateMachineType(cloudProfile, machineTypeIndex, region, worker, machinePath.Child("type"))...)
+	}
+
+	image := worker.Machine.Image
+	if image == nil || image.Version == nil || sameImage(image, existingWorker.Machine.Image) {
+		return allErrs
+	}
+
+	imageIndex := slices.IndexFunc(cloudProfile.Spec.MachineImages, func(machineImage gardener.MachineImage) bool {
+		return machineImage.Name == image.Name
+	})
+	if imageIndex < 0 {
+		return append(allErrs, field.Invalid(machinePath.Child("image", "name"), image.Name, fmt.Sprintf("is not available in the cloud profile %s", cloudProfile.Name)))
+	}
+
+	imageVersions := cloudProfile.Spec.MachineImages[imageIndex].Versions
+	versionPath := machinePath.Child("image", "version")
+
+	expirableVersions := make([]gardener.ExpirableVersion, 0, len(imageVersions))
+	for _, imageVersion := range imageVersions {
+		expirableVersions = append(expirableVersions, imageVersion.ExpirableVersion)
+	}
+	if msg := checkVersion(expirableVersions, *image.Version, now); msg != "" {
+		return append(allErrs, field.Invalid(versionPath, *image.Version, fmt.Sprintf("%s for the image %s in the cloud profile %s", msg, image.Name, cloudProfile.Name)))
+	}
+
+	architecture := workerArchitecture(cloudProfile, machineTypeIndex, worker)
+	imageVersion := imageVersions[slices.IndexFunc(imageVersions, func(v gardener.MachineImageVersion) bool { return v.Version == *image.Version })]
+	architectures := imageVersion.Architectures
+	if len(architectures) == 0 {
+		architectures = []string{defaultArchitecture}
+	}
+	if !slices.Contains(architectures, architecture) {
+		allErrs = append(allErrs, field.Invalid(versionPath, *image.Version, fmt.Sprintf("image %s does not support the architecture %s of the machine type %s, supported architectures: %v", image.Name, architecture, worker.Machine.Type, architectures)))
+	}
+
+	return allErrs
+}
+
+func validateZones(cloudProfile *gardener.CloudProfile, region gardener.Region, worker, existingWorker gardener.Worker, fldPath *field.Path) field.ErrorList {
+	availableZones := make([]string, 0, len(region.Zones))
+	for _, zone := range region.Zones {
+		availableZones = append(availableZones, zone.Name)
+	}
+
+	var allErrs field.ErrorList
+	for _, zone := range worker.Zones {
+		if !slices.Contains(availableZones, zone) && !slices.Contains(existingWorker.Zones, zone) {
+			allErrs = append(allErrs, field.Invalid(fldPath, zone, fmt.Sprintf("is not available in the region %s of the cloud profile %s, available zones: %s", region.Name, cloudProfile.Name, strings.Join(availableZones, ", "))))
+		}
+	}
+	return allErrs
+}
+
+func validateMachineType(cloudProfile *gardener.CloudProfile, machineTypeIndex int, region gardener.Region, worker gardener.Worker, fldPath *field.Path) field.ErrorList {
+	if machineTypeIndex < 0 {
+		return field.ErrorList{field.Invalid(fldPath, worker.Machine.Type, fmt.Sprintf("is not available in the cloud profile %s", cloudProfile.Name))}
+	}
+
+	machineType := cloudProfile.Spec.MachineTypes[machineTypeIndex]
+	if machineType.Usable != nil && !*machineType.Usable {
+		return field.ErrorList{field.Invalid(fldPath, worker.Machine.Type, fmt.Sprintf("is not usable in the cloud profile %s", cloudProfile.Name))}
+	}
+
+	var allErrs field.ErrorList
+	for _, zone := range region.Zones {
+		if slices.Contains(worker.Zones, zone.Name) && slices.Contains(zone.UnavailableMachineTypes, worker.Machine.Type) {
+			allErrs = append(allErrs, field.Invalid(fldPath, worker.Machine.Type, fmt.Sprintf("is not available in the zone %s", zone.Name)))
+		}
+	}
+	return allErrs
+}
+
+// checkVersion returns the reason why the version cannot be used, empty if it can be used
+func checkVersion(versions []gardener.ExpirableVersion, version string, now time.Time) string {
+	index := slices.IndexFunc(versions, func(v gardener.ExpirableVersion) bool {
+		return v.Version == version
+	})
+	if index < 0 {
+		return "is not available"
+	}
+
+	if expirationDate := versions[index].ExpirationDate; expirationDate != nil && !now.Before(expirationDate.Time) {
+		return fmt.Sprintf("expired on %s", expirationDate.UTC().Format(time.DateOnly))
+	}
+	return ""
+}
+
+func workerArchitecture(cloudProfile *gardener.CloudProfile, machineTypeIndex int, worker gardener.Worker) string {
+	if worker.Machine.Architecture != nil {
+		return *worker.Machine.Architecture
+	}
+	if machineTypeIndex >= 0 && cloudProfile.Spec.MachineTypes[machineTypeIndex].Architecture != nil {
+		return *cloudProfile.Spec.MachineTypes[machineTypeIndex].Architecture
+	}
+	return defaultArchitecture
+}
+
+func sameImage(image, existingImage *gardener.ShootMachineImage) bool {
+	return existingImage != nil && existingImage.Version != nil &&
+		image.Name == existingImage.Name && *image.Version == *existingImage.Version
+}
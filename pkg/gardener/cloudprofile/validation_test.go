@@ -0,0 +1,151 @@
+package cloudprofile
+
+import (
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateShoot(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	cloudProfile := fixCloudProfileWithVersions(now)
+
+	for _, testCase := range []struct {
+		name           string
+		shoot          *gardener.Shoot
+		existingShoot  *gardener.Shoot
+		expectedErrors []string
+	}{
+		{
+			name:  "Valid shoot",
+			shoot: fixShoot("1.30.2", fixWorker("main", "m6i.large", "gardenlinux", "1592.1.0", "eu-central-1a", "eu-central-1c")),
+		},
+		{
+			name:  "Valid shoot with arm64 workers",
+			shoot: fixShoot("1.30.2", fixWorker("main", "m6g.large", "gardenlinux", "1592.1.0", "eu-central-1a")),
+		},
+		{
+			name:  "Unknown region",
+			shoot: func() *gardener.Shoot { s := fixShoot("1.30.2"); s.Spec.Region = "us-east-1"; return s }(),
+			expectedErrors: []string{
+				`spec.shoot.region: Invalid value: "us-east-1": is not available in the cloud profile aws`,
+			},
+		},
+		{
+			name:  "Unknown and expired versions",
+			shoot: fixShoot("1.31.0", fixWorker("main", "m6i.large", "gardenlinux", "1443.3.0", "eu-central-1a"), fixWorker("additional", "m6i.large", "gardenlinux", "1.0.0", "eu-central-1a")),
+			expectedErrors: []string{
+				`spec.shoot.kubernetes.version: Invalid value: "1.31.0": is not available in the cloud profile aws`,
+				`spec.shoot.provider.workers[main].machine.image.version: Invalid value: "1443.3.0": expired on 2025-05-01 for the image gardenlinux in the cloud profile aws`,
+				`spec.shoot.provider.workers[additional].machine.image.version: Invalid value: "1.0.0": is not available for the image gardenlinux in the cloud profile aws`,
+			},
+		},
+		{
+			name:  "Expired Kubernetes version",
+			shoot: fixShoot("1.29.10"),
+			expectedErrors: []string{
+				`spec.shoot.kubernetes.version: Invalid value: "1.29.10": expired on 2025-05-01 in the cloud profile aws`,
+			},
+		},
+		{
+			name:  "Unknown, unusable and unavailable machine types and images",
+			shoot: fixShoot("1.30.2", fixWorker("main", "m7.large", "ubuntu", "22.04", "eu-central-1a"), fixWorker("unusable", "m5.large", "gardenlinux", "1592.1.0", "eu-central-1a"), fixWorker("zonal", "m6i.2xlarge", "gardenlinux", "1592.1.0", "eu-central-1a", "eu-central-1c")),
+			expectedErrors: []string{
+				`spec.shoot.provider.workers[main].machine.type: Invalid value: "m7.large": is not available in the cloud profile aws`,
+				`spec.shoot.provider.workers[main].machine.image.name: Invalid value: "ubuntu": is not available in the cloud profile aws`,
+				`spec.shoot.provider.workers[unusable].machine.type: Invalid value: "m5.large": is not usable in the cloud profile aws`,
+				`spec.shoot.provider.workers[zonal].machine.type: Invalid value: "m6i.2xlarge": is not available in the zone eu-central-1c`,
+			},
+		},
+		{
+			name:  "Zones not available in the region",
+			shoot: fixShoot("1.30.2", fixWorker("main", "m6i.large", "gardenlinux", "1592.1.0", "eu-central-1a", "eu-central-1x"), fixWorker("additional", "m6i.large", "gardenlinux", "1592.1.0", "us-east-1a")),
+			expectedErrors: []string{
+				`spec.shoot.provider.workers[main].zones: Invalid value: "eu-central-1x": is not available in the region eu-central-1 of the cloud profile aws, available zones: eu-central-1a, eu-central-1b, eu-central-1c`,
+				`spec.shoot.provider.workers[additional].zones: Invalid value: "us-east-1a": is not available in the region eu-central-1 of the cloud profile aws, available zones: eu-central-1a, eu-central-1b, eu-central-1c`,
+			},
+		},
+		{
+			name:  "Image not supporting the machine type architecture",
+			shoot: fixShoot("1.30.2", fixWorker("main", "m6g.large", "gardenlinux", "1600.0.0", "eu-central-1a")),
+			expectedErrors: []string{
+				`spec.shoot.provider.workers[main].machine.image.version: Invalid value: "1600.0.0": image gardenlinux does not support the architecture arm64 of the machine type m6g.large, supported architectures: [amd64]`,
+			},
+		},
+		{
+			name:          "Values used by the existing shoot are not checked",
+			shoot:         fixShoot("1.29.10", fixWorker("main", "m5.large", "gardenlinux", "1443.3.0", "eu-central-1a", "eu-central-1x"), fixWorker("additional", "m5.large", "gardenlinux", "1443.3.0", "eu-central-1a")),
+			existingShoot: fixShoot("1.29.10", fixWorker("main", "m5.large", "gardenlinux", "1443.3.0", "eu-central-1a", "eu-central-1x")),
+			expectedErrors: []string{
+				`spec.shoot.provider.workers[additional].machine.type: Invalid value: "m5.large": is not usable in the cloud profile aws`,
+				`spec.shoot.provider.workers[additional].machine.image.version: Invalid value: "1443.3.0": expired on 2025-05-01 for the image gardenlinux in the cloud profile aws`,
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// when
+			errs := ValidateShoot(cloudProfile, testCase.shoot, testCase.existingShoot, now)
+
+			// then
+			var errStrings []string
+			for _, err := range errs {
+				errStrings = append(errStrings, err.Error())
+			}
+			assert.Equal(t, testCase.expectedErrors, errStrings)
+		})
+	}
+}
+
+func fixCloudProfileWithVersions(now time.Time) *gardener.CloudProfile {
+	expired := &metav1.Time{Time: now.AddDate(0, -1, 0)}
+	expiring := &metav1.Time{Time: now.AddDate(0, 1, 0)}
+
+	cloudProfile := fixCloudProfile("aws")
+	cloudProfile.Spec.Regions[0].Zones[2].UnavailableMachineTypes = []string{"m6i.2xlarge"}
+	cloudProfile.Spec.Kubernetes.Versions = []gardener.ExpirableVersion{
+		{Version: "1.30.2", ExpirationDate: expiring},
+		{Version: "1.29.10", ExpirationDate: expired},
+	}
+	cloudProfile.Spec.MachineTypes = []gardener.MachineType{
+		{Name: "m6i.large", Architecture: ptr.To("amd64")},
+		{Name: "m6i.2xlarge", Architecture: ptr.To("amd64")},
+		{Name: "m6g.large", Architecture: ptr.To("arm64")},
+		{Name: "m5.large", Usable: ptr.To(false)},
+	}
+	cloudProfile.Spec.MachineImages = []gardener.MachineImage{
+		{
+			Name: "gardenlinux",
+			Versions: []gardener.MachineImageVersion{
+				{ExpirableVersion: gardener.ExpirableVersion{Version: "1600.0.0"}},
+				{ExpirableVersion: gardener.ExpirableVersion{Version: "1592.1.0"}, Architectures: []string{"amd64", "arm64"}},
+				{ExpirableVersion: gardener.ExpirableVersion{Version: "1443.3.0", ExpirationDate: expired}, Architectures: []string{"amd64", "arm64"}},
+			},
+		},
+	}
+	return cloudProfile
+}
+
+func fixShoot(kubernetesVersion string, workers ...gardener.Worker) *gardener.Shoot {
+	return &gardener.Shoot{
+		Spec: gardener.ShootSpec{
+			Region:     "eu-central-1",
+			Kubernetes: gardener.Kubernetes{Version: kubernetesVersion},
+			Provider:   gardener.Provider{Workers: workers},
+		},
+	}
+}
+
+func fixWorker(name, machineType, imageName, imageVersion string, zones ...string) gardener.Worker {
+	return gardener.Worker{
+		Name: name,
+		Machine: gardener.Machine{
+			Type:  machineType,
+			Image: &gardener.ShootMachineImage{Name: imageName, Version: ptr.To(imageVersion)},
+		},
+		Zones: zones,
+	}
+}
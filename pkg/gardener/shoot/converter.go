@@ -17,13 +17,12 @@ type Extend func(imv1.Runtime, *gardener.Shoot) error
 
 func baseExtenders(cfg config.ConverterConfig) []Extend {
 	return []Extend{
-		extender2.ExtendWithAnnotations,
+		extender2.NewAnnotationsExtender(cfg.EffectiveShootRules()),
 		extender2.ExtendWithLabels,
 		extender2.ExtendWithSeedSelector,
 		extender2.NewOidcExtender(cfg.Kubernetes.DefaultOperatorOidc),
-		extender2.ExtendWithCloudProfile,
-		extender2.ExtendWithExposureClassName,
-		extender2.NewMaintenanceExtender(cfg.Kubernetes.EnableKubernetesVersionAutoUpdate, cfg.Kubernetes.EnableMachineImageVersionAutoUpdate),
+		extender2.NewKubeAPIServerExtender(),
+		extender2.NewKubernetesComponentsExtender(cfg.Kubernetes.Components),
 	}
 }
 
@@ -58,19 +57,28 @@ type PatchOpts struct {
 	Resources            []gardener.NamedResourceReference
 	InfrastructureConfig *runtime.RawExtension
 	ControlPlaneConfig   *runtime.RawExtension
+	CloudProfileName     *string
+	ExposureClassName    *string
+	// MaintenanceTimeWindow of the existing shoot, kept if the Runtime does not specify the time window
+	MaintenanceTimeWindow *gardener.MaintenanceTimeWindow
 }
 
 func NewConverterCreate(opts CreateOpts) Converter {
 	extendersForCreate := baseExtenders(opts.ConverterConfig)
 
 	extendersForCreate = append(extendersForCreate,
+		extender2.ExtendWithNetworking,
+		extender2.NewProviderSettingsExtender(false),
+		extender2.NewMaintenanceExtender(opts.Kubernetes.EnableKubernetesVersionAutoUpdate, opts.Kubernetes.EnableMachineImageVersionAutoUpdate, nil),
+		extender2.NewCloudProfileExtender(opts.CloudProfile, nil),
+		extender2.NewExposureClassNameExtender(opts.Provider, nil),
 		extender2.NewProviderExtenderForCreateOperation(
-			opts.Provider.AWS.EnableIMDSv2,
+			opts.Provider,
 			opts.MachineImage.DefaultName,
 			opts.MachineImage.DefaultVersion,
 		),
 		extender2.NewDNSExtender(opts.DNS.SecretName, opts.DNS.DomainPrefix, opts.DNS.ProviderType),
-		extender2.ExtendWithTolerations,
+		extender2.NewTolerationsExtender(opts.EffectiveShootRules()),
 	)
 
 	extendersForCreate = append(extendersForCreate, extensions.NewExtensionsExtenderForCreate(opts.ConverterConfig, opts.AuditLogData))
@@ -92,8 +100,12 @@ func NewConverterPatch(opts PatchOpts) Converter {
 	extendersForPatch := baseExtenders(opts.ConverterConfig)
 
 	extendersForPatch = append(extendersForPatch,
+		extender2.NewProviderSettingsExtender(true),
+		extender2.NewMaintenanceExtender(opts.Kubernetes.EnableKubernetesVersionAutoUpdate, opts.Kubernetes.EnableMachineImageVersionAutoUpdate, opts.MaintenanceTimeWindow),
+		extender2.NewCloudProfileExtender(opts.CloudProfile, opts.CloudProfileName),
+		extender2.NewExposureClassNameExtender(opts.Provider, opts.ExposureClassName),
 		extender2.NewProviderExtenderPatchOperation(
-			opts.Provider.AWS.EnableIMDSv2,
+			opts.Provider,
 			opts.MachineImage.DefaultName,
 			opts.MachineImage.DefaultVersion,
 			opts.Workers,
@@ -101,7 +113,7 @@ func NewConverterPatch(opts PatchOpts) Converter {
 			opts.ControlPlaneConfig))
 
 	extendersForPatch = append(extendersForPatch,
-		extensions.NewExtensionsExtenderForPatch(opts.AuditLogData, opts.Extensions),
+		extensions.NewExtensionsExtenderForPatch(opts.ConverterConfig, opts.AuditLogData, opts.Extensions),
 		extender2.NewResourcesExtenderForPatch(opts.Resources))
 
 	extendersForPatch = append(extendersForPatch, extender2.NewKubernetesExtender(opts.Kubernetes.DefaultVersion, opts.ShootK8SVersion))
@@ -135,12 +147,14 @@ func (c Converter) ToShoot(runtime imv1.Runtime) (gardener.Shoot, error) {
 			Region:            runtime.Spec.Shoot.Region,
 			SecretBindingName: &runtime.Spec.Shoot.SecretBindingName,
 			Networking: &gardener.Networking{
-				Type:     runtime.Spec.Shoot.Networking.Type,
-				Nodes:    &runtime.Spec.Shoot.Networking.Nodes,
-				Pods:     &runtime.Spec.Shoot.Networking.Pods,
-				Services: &runtime.Spec.Shoot.Networking.Services,
+				Type:       runtime.Spec.Shoot.Networking.Type,
+				Nodes:      &runtime.Spec.Shoot.Networking.Nodes,
+				Pods:       &runtime.Spec.Shoot.Networking.Pods,
+				Services:   &runtime.Spec.Shoot.Networking.Services,
+				IPFamilies: runtime.Spec.Shoot.Networking.IPFamilies,
 			},
 			ControlPlane: runtime.Spec.Shoot.ControlPlane,
+			Hibernation:  runtime.Spec.Shoot.Hibernation,
 		},
 	}
 
@@ -10,6 +10,7 @@ import (
 	"k8s.io/utils/ptr"
 	"strings"
 	"testing"
+	"time"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/go-playground/validator/v10"
@@ -48,6 +49,13 @@ func TestConverter(t *testing.T) {
 
 		extensionLen := len(shoot.Spec.Extensions)
 		require.Equalf(t, 5, extensionLen, "unexpected number of extensions: %d, expected: 5", extensionLen)
+
+		kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+		require.NotNil(t, kubeAPIServer)
+		assert.Equal(t, runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID, kubeAPIServer.OIDCConfig.ClientID)
+		assert.Equal(t, converterConfig.AuditLog.PolicyConfigMapName, kubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name)
+		assert.Equal(t, runtime.Spec.Shoot.Kubernetes.KubeAPIServer.EventTTL, kubeAPIServer.EventTTL)
+		assert.Nil(t, kubeAPIServer.EnableAnonymousAuthentication)
 	})
 
 	t.Run("Create shoot from Runtime with empty Auditlog Configuration", func(t *testing.T) {
@@ -187,6 +195,31 @@ func TestConverter(t *testing.T) {
 		extensionLen := len(shoot.Spec.Extensions)
 		require.Equalf(t, extensionLen, 5, "unexpected number of extensions: %d, expected: 5", extensionLen)
 	})
+
+	t.Run("Validate the networking of the new shoot only", func(t *testing.T) {
+		// given
+		runtime := fixRuntime()
+		runtime.Spec.Shoot.Networking.Pods = "100.64.0.1/12"
+		converterConfig := fixConverterConfig()
+
+		createConverter := NewConverterCreate(CreateOpts{ConverterConfig: converterConfig})
+		patchConverter := NewConverterPatch(PatchOpts{
+			ConverterConfig:      converterConfig,
+			Workers:              fixWorkersWithReversedZones("gardenlinux", "1591.0.0"),
+			ShootK8SVersion:      "1.28",
+			Extensions:           fixAllExtensionsOnTheShoot(),
+			InfrastructureConfig: fixAWSInfrastructureConfig("10.250.0.0/16", []string{"eu-central-1c", "eu-central-1b", "eu-central-1a"}),
+			ControlPlaneConfig:   fixAWSControlPlaneConfig(),
+		})
+
+		// when
+		_, createErr := createConverter.ToShoot(runtime)
+		_, patchErr := patchConverter.ToShoot(runtime)
+
+		// then
+		require.ErrorContains(t, createErr, "spec.shoot.networking.pods")
+		require.NoError(t, patchErr)
+	})
 }
 
 func assertShootFields(t *testing.T, runtime imv1.Runtime, shoot gardener.Shoot) {
@@ -194,9 +227,11 @@ func assertShootFields(t *testing.T, runtime imv1.Runtime, shoot gardener.Shoot)
 	assert.Equal(t, runtime.Spec.Shoot.Region, shoot.Spec.Region)
 	assert.Equal(t, runtime.Spec.Shoot.SecretBindingName, *shoot.Spec.SecretBindingName)
 	assert.Equal(t, runtime.Spec.Shoot.ControlPlane, shoot.Spec.ControlPlane)
+	assert.Equal(t, runtime.Spec.Shoot.Hibernation, shoot.Spec.Hibernation)
 	assert.Equal(t, runtime.Spec.Shoot.Networking.Nodes, *shoot.Spec.Networking.Nodes)
 	assert.Equal(t, runtime.Spec.Shoot.Networking.Pods, *shoot.Spec.Networking.Pods)
 	assert.Equal(t, runtime.Spec.Shoot.Networking.Services, *shoot.Spec.Networking.Services)
+	assert.Equal(t, runtime.Spec.Shoot.Networking.IPFamilies, shoot.Spec.Networking.IPFamilies)
 	assert.Equal(t, "Shoot", shoot.TypeMeta.Kind)
 	assert.Equal(t, "core.gardener.cloud/v1beta1", shoot.TypeMeta.APIVersion)
 }
@@ -244,6 +279,9 @@ func fixConverterConfig() config.ConverterConfig {
 			DefaultName:    "gardenlinux",
 			DefaultVersion: "1592.1.0",
 		},
+		APIServerACL: config.APIServerACLConfig{
+			KCPEgressCIDRs: []string{"192.168.0.0/24"},
+		},
 	}
 }
 
@@ -340,6 +378,7 @@ func fixRuntime() imv1.Runtime {
 							},
 							UsernameClaim: &usernameClaim,
 						},
+						EventTTL: &v1.Duration{Duration: 30 * time.Minute},
 					},
 				},
 				Networking: imv1.Networking{
@@ -354,6 +393,12 @@ func fixRuntime() imv1.Runtime {
 						},
 					},
 				},
+				Hibernation: &gardener.Hibernation{
+					Enabled: ptr.To(false),
+					Schedules: []gardener.HibernationSchedule{
+						{Start: ptr.To("00 20 * * 1,2,3,4,5"), End: ptr.To("00 08 * * 1,2,3,4,5"), Location: ptr.To("Europe/Berlin")},
+					},
+				},
 			},
 		},
 	}
@@ -5,6 +5,7 @@ import (
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 )
 
 // Provisioner was setting the following annotations:
@@ -13,6 +14,9 @@ import (
 //- support.gardener.cloud/eu-access-for-cluster-nodes
 
 const (
+	// All annotations set by KIM on the shoot use this prefix
+	ShootKIMAnnotationPrefix = "infrastructuremanager.kyma-project.io/"
+
 	ShootRuntimeGenerationAnnotation  = "infrastructuremanager.kyma-project.io/runtime-generation"
 	ShootRuntimeIDAnnotation          = "infrastructuremanager.kyma-project.io/runtime-id"
 	ShootLicenceTypeAnnotation        = "infrastructuremanager.kyma-project.io/licence-type"
@@ -20,35 +24,32 @@ const (
 	ShootRestrictedEUAccessAnnotation = "support.gardener.cloud/eu-access-for-cluster-nodes"
 )
 
-func ExtendWithAnnotations(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-	shoot.Annotations = getAnnotations(runtime)
+// Annotations from the matching shoot rules are set first, they cannot override the annotations set by KIM
+func NewAnnotationsExtender(shootRules []config.ShootRule) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		shoot.Annotations = getAnnotations(runtime, shootRules)
 
-	return nil
+		return nil
+	}
 }
 
-func getAnnotations(runtime imv1.Runtime) map[string]string {
-	annotations := map[string]string{
-		ShootRuntimeIDAnnotation:         runtime.Labels[RuntimeIDLabel],
-		ShootRuntimeGenerationAnnotation: fmt.Sprintf("%v", runtime.Generation),
+func getAnnotations(runtime imv1.Runtime, shootRules []config.ShootRule) map[string]string {
+	annotations := map[string]string{}
+
+	for _, rule := range shootRules {
+		if rule.Matches(runtime.Spec.Shoot.PlatformRegion, runtime.Spec.Shoot.Region) {
+			for key, value := range rule.Annotations {
+				annotations[key] = value
+			}
+		}
 	}
 
+	annotations[ShootRuntimeIDAnnotation] = runtime.Labels[RuntimeIDLabel]
+	annotations[ShootRuntimeGenerationAnnotation] = fmt.Sprintf("%v", runtime.Generation)
+
 	if runtime.Spec.Shoot.LicenceType != nil && *runtime.Spec.Shoot.LicenceType != "" {
 		annotations[ShootLicenceTypeAnnotation] = *runtime.Spec.Shoot.LicenceType
 	}
 
-	if isEuAccess(runtime.Spec.Shoot.PlatformRegion) {
-		annotations[ShootRestrictedEUAccessAnnotation] = "true"
-	}
-
 	return annotations
 }
-
-func isEuAccess(platformRegion string) bool {
-	switch platformRegion {
-	case "cf-eu11":
-		return true
-	case "cf-ch20":
-		return true
-	}
-	return false
-}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -101,10 +102,72 @@ func TestAnnotationsExtender(t *testing.T) {
 		shoot := fixEmptyGardenerShoot("shoot", "kcp-system")
 
 		// when
-		err := ExtendWithAnnotations(testCase.runtime, &shoot)
+		err := NewAnnotationsExtender(config.DefaultShootRules())(testCase.runtime, &shoot)
 		require.NoError(t, err)
 
 		// then
 		assert.Equal(t, testCase.expectedAnnotations, shoot.Annotations)
 	}
 }
+
+func TestAnnotationsExtenderWithShootRules(t *testing.T) {
+	// given
+	shootRules := []config.ShootRule{
+		{
+			PlatformRegions: []string{"cf-eu30"},
+			Annotations: map[string]string{
+				"support.gardener.cloud/eu-access-for-cluster-nodes": "true",
+				"infrastructuremanager.kyma-project.io/runtime-id":   "overridden",
+			},
+		},
+		{
+			Regions:     []string{"eu-central-2"},
+			Annotations: map[string]string{"example.com/sovereign": "true"},
+		},
+	}
+	runtime := imv1.Runtime{
+		ObjectMeta: v1.ObjectMeta{
+			Labels: map[string]string{
+				"kyma-project.io/runtime-id": "runtime-id",
+			},
+		},
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				PlatformRegion: "cf-eu30",
+				Region:         "eu-central-2",
+			},
+		},
+	}
+	shoot := fixEmptyGardenerShoot("shoot", "kcp-system")
+
+	// when
+	err := NewAnnotationsExtender(shootRules)(runtime, &shoot)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"infrastructuremanager.kyma-project.io/runtime-id":         "runtime-id",
+		"infrastructuremanager.kyma-project.io/runtime-generation": "0",
+		"support.gardener.cloud/eu-access-for-cluster-nodes":       "true",
+		"example.com/sovereign":                                    "true",
+	}, shoot.Annotations)
+}
+
+func TestAnnotationsExtenderWithoutShootRules(t *testing.T) {
+	// given
+	runtime := imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				PlatformRegion: "cf-eu11",
+			},
+		},
+	}
+	shoot := fixEmptyGardenerShoot("shoot", "kcp-system")
+
+	// when
+	err := NewAnnotationsExtender([]config.ShootRule{})(runtime, &shoot)
+
+	// then
+	require.NoError(t, err)
+	assert.NotContains(t, shoot.Annotations, "support.gardener.cloud/eu-access-for-cluster-nodes")
+}
@@ -3,41 +3,36 @@ package extender
 import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
-	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 )
 
-const (
-	DefaultAWSCloudProfileName       = "aws"
-	DefaultAzureCloudProfileName     = "az"
-	DefaultGCPCloudProfileName       = "gcp"
-	DefaultOpenStackCloudProfileName = "converged-cloud-kyma"
-)
-
-func ExtendWithCloudProfile(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-	cloudProfileName, err := getCloudProfileName(runtime)
-
-	if err != nil {
-		return err
+// Cloud profile is taken from the converter configuration, the name built into the hyperscaler provider is used if none is configured.
+// The cloud profile of the existing shoot is kept when patching.
+func NewCloudProfileExtender(cloudProfileConfig config.CloudProfileConfig, existingCloudProfileName *string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		if existingCloudProfileName != nil && *existingCloudProfileName != "" {
+			shoot.Spec.CloudProfileName = ptr.To(*existingCloudProfileName)
+			return nil
+		}
+
+		hyperscalerProvider, err := hyperscaler.Get(runtime.Spec.Shoot.Provider.Type)
+		if err != nil {
+			return err
+		}
+
+		cloudProfileName := cloudProfileConfig.CloudProfileName(
+			runtime.Spec.Shoot.Provider.Type,
+			runtime.Spec.Shoot.Region,
+			runtime.Labels[imv1.LabelKymaBrokerPlanName],
+		)
+		if cloudProfileName == "" {
+			cloudProfileName = hyperscalerProvider.CloudProfileName()
+		}
+
+		shoot.Spec.CloudProfileName = ptr.To(cloudProfileName)
+
+		return nil
 	}
-
-	shoot.Spec.CloudProfileName = ptr.To(cloudProfileName)
-
-	return nil
-}
-
-func getCloudProfileName(runtime imv1.Runtime) (string, error) {
-	switch runtime.Spec.Shoot.Provider.Type {
-	case hyperscaler.TypeAWS:
-		return DefaultAWSCloudProfileName, nil
-	case hyperscaler.TypeGCP:
-		return DefaultGCPCloudProfileName, nil
-	case hyperscaler.TypeAzure:
-		return DefaultAzureCloudProfileName, nil
-	case hyperscaler.TypeOpenStack:
-		return DefaultOpenStackCloudProfileName, nil
-	}
-
-	return "", errors.New("provider not supported")
 }
@@ -4,9 +4,15 @@ import (
 	"testing"
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/gcp"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/openstack"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -19,22 +25,22 @@ func TestExtendWithCloudProfile(t *testing.T) {
 		{
 			name:            "Set cloud profile name for aws",
 			providerType:    hyperscaler.TypeAWS,
-			expectedProfile: ptr.To(DefaultAWSCloudProfileName),
+			expectedProfile: ptr.To(aws.DefaultCloudProfileName),
 		},
 		{
 			name:            "Set cloud profile name for azure",
 			providerType:    hyperscaler.TypeAzure,
-			expectedProfile: ptr.To(DefaultAzureCloudProfileName),
+			expectedProfile: ptr.To(azure.DefaultCloudProfileName),
 		},
 		{
 			name:            "Set cloud profile for gcp",
 			providerType:    hyperscaler.TypeGCP,
-			expectedProfile: ptr.To(DefaultGCPCloudProfileName),
+			expectedProfile: ptr.To(gcp.DefaultCloudProfileName),
 		},
 		{
 			name:            "Set cloud profile for openstack",
 			providerType:    hyperscaler.TypeOpenStack,
-			expectedProfile: ptr.To(DefaultOpenStackCloudProfileName),
+			expectedProfile: ptr.To(openstack.DefaultCloudProfileName),
 		},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -52,7 +58,7 @@ func TestExtendWithCloudProfile(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("test", "dev")
 
 			// when
-			err := ExtendWithCloudProfile(runtime, &shoot)
+			err := NewCloudProfileExtender(config.CloudProfileConfig{}, nil)(runtime, &shoot)
 
 			// then
 			require.NoError(t, err)
@@ -60,7 +66,7 @@ func TestExtendWithCloudProfile(t *testing.T) {
 		})
 	}
 
-	t.Run("", func(t *testing.T) {
+	t.Run("Return error for unknown provider", func(t *testing.T) {
 		// given
 		runtime := imv1.Runtime{
 			Spec: imv1.RuntimeSpec{
@@ -75,9 +81,84 @@ func TestExtendWithCloudProfile(t *testing.T) {
 		shoot := fixEmptyGardenerShoot("test", "dev")
 
 		// when
-		err := ExtendWithCloudProfile(runtime, &shoot)
+		err := NewCloudProfileExtender(config.CloudProfileConfig{}, nil)(runtime, &shoot)
 
 		// then
 		require.Error(t, err)
 	})
+	t.Run("Set cloud profile from configuration", func(t *testing.T) {
+		cloudProfileConfig := config.CloudProfileConfig{
+			Defaults: map[string]string{
+				hyperscaler.TypeAWS: "aws-default",
+			},
+			Overrides: []config.CloudProfileOverride{
+				{Provider: hyperscaler.TypeAWS, Region: "eu-central-2", Name: "aws-sovereign"},
+				{Provider: hyperscaler.TypeAWS, Plan: "trial", Name: "aws-trial"},
+				{Provider: hyperscaler.TypeAzure, Region: "eu-central-2", Name: "az-sovereign"},
+			},
+		}
+
+		for _, testCase := range []struct {
+			name            string
+			providerType    string
+			region          string
+			plan            string
+			expectedProfile string
+		}{
+			{"region override", hyperscaler.TypeAWS, "eu-central-2", "aws", "aws-sovereign"},
+			{"plan override", hyperscaler.TypeAWS, "eu-central-1", "trial", "aws-trial"},
+			{"provider default", hyperscaler.TypeAWS, "eu-central-1", "aws", "aws-default"},
+			{"built-in default", hyperscaler.TypeGCP, "eu-central-2", "gcp", gcp.DefaultCloudProfileName},
+		} {
+			t.Run(testCase.name, func(t *testing.T) {
+				// given
+				runtime := imv1.Runtime{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{imv1.LabelKymaBrokerPlanName: testCase.plan},
+					},
+					Spec: imv1.RuntimeSpec{
+						Shoot: imv1.RuntimeShoot{
+							Name:     "myshoot",
+							Region:   testCase.region,
+							Provider: imv1.Provider{Type: testCase.providerType},
+						},
+					},
+				}
+				shoot := fixEmptyGardenerShoot("test", "dev")
+
+				// when
+				err := NewCloudProfileExtender(cloudProfileConfig, nil)(runtime, &shoot)
+
+				// then
+				require.NoError(t, err)
+				assert.Equal(t, ptr.To(testCase.expectedProfile), shoot.Spec.CloudProfileName)
+			})
+		}
+	})
+
+	t.Run("Keep cloud profile of the existing shoot", func(t *testing.T) {
+		// given
+		runtime := imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Name:     "myshoot",
+					Region:   "eu-central-2",
+					Provider: imv1.Provider{Type: hyperscaler.TypeAWS},
+				},
+			},
+		}
+		cloudProfileConfig := config.CloudProfileConfig{
+			Overrides: []config.CloudProfileOverride{
+				{Provider: hyperscaler.TypeAWS, Region: "eu-central-2", Name: "aws-sovereign"},
+			},
+		}
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewCloudProfileExtender(cloudProfileConfig, ptr.To("aws"))(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To("aws"), shoot.Spec.CloudProfileName)
+	})
 }
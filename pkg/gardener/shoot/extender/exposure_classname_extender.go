@@ -3,15 +3,31 @@ package extender
 import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
 	"k8s.io/utils/ptr"
 )
 
-// ExposureClassName is required only for OpenStack
-func ExtendWithExposureClassName(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-	if runtime.Spec.Shoot.Provider.Type == hyperscaler.TypeOpenStack {
-		shoot.Spec.ExposureClassName = ptr.To("converged-cloud-internet")
-	}
+// ExposureClassName is set only for providers requiring it (OpenStack).
+// The exposure class of the existing shoot is kept when patching.
+func NewExposureClassNameExtender(providerConfig config.ProviderConfig, existingExposureClassName *string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		hyperscalerProvider, err := hyperscaler.Get(runtime.Spec.Shoot.Provider.Type)
+		if err != nil {
+			return err
+		}
+
+		if existingExposureClassName != nil && *existingExposureClassName != "" {
+			shoot.Spec.ExposureClassName = ptr.To(*existingExposureClassName)
+			return nil
+		}
 
-	return nil
+		shoot.Spec.ExposureClassName = hyperscalerProvider.ExposureClassName(hyperscaler.ConfigOpts{
+			Region:   runtime.Spec.Shoot.Region,
+			Config:   providerConfig,
+			Provider: runtime.Spec.Shoot.Provider,
+		})
+
+		return nil
+	}
 }
@@ -4,26 +4,72 @@ import (
 	"testing"
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 )
 
-func TestExtendWithExposureClassName(t *testing.T) {
+func TestExposureClassNameExtender(t *testing.T) {
+	openStackConfig := config.ProviderConfig{
+		OpenStack: config.OpenStackConfig{
+			OpenStackSettings: config.OpenStackSettings{ExposureClassName: "configured-default"},
+			Regions: map[string]config.OpenStackSettings{
+				"eu-de-2": {ExposureClassName: "configured-eu-de-2"},
+			},
+		},
+	}
+
 	for _, testCase := range []struct {
-		name                         string
-		providerType                 string
-		expectedExposureClassNameSet bool
+		name                      string
+		providerType              string
+		region                    string
+		providerConfig            config.ProviderConfig
+		openStack                 *imv1.OpenStackProvider
+		existingExposureClassName *string
+		expectedExposureClassName *string
 	}{
 		{
-			name:                         "ExposureClassName not set for AWS",
-			providerType:                 hyperscaler.TypeAWS,
-			expectedExposureClassNameSet: false,
+			name:                      "ExposureClassName not set for AWS",
+			providerType:              hyperscaler.TypeAWS,
+			expectedExposureClassName: nil,
+		},
+		{
+			name:                      "ExposureClassName set for OpenStack",
+			providerType:              hyperscaler.TypeOpenStack,
+			expectedExposureClassName: ptr.To("converged-cloud-internet"),
+		},
+		{
+			name:                      "ExposureClassName taken from the configured defaults",
+			providerType:              hyperscaler.TypeOpenStack,
+			region:                    "eu-de-1",
+			providerConfig:            openStackConfig,
+			expectedExposureClassName: ptr.To("configured-default"),
+		},
+		{
+			name:                      "ExposureClassName taken from the region configuration",
+			providerType:              hyperscaler.TypeOpenStack,
+			region:                    "eu-de-2",
+			providerConfig:            openStackConfig,
+			expectedExposureClassName: ptr.To("configured-eu-de-2"),
+		},
+		{
+			name:                      "ExposureClassName taken from the Runtime CR",
+			providerType:              hyperscaler.TypeOpenStack,
+			region:                    "eu-de-2",
+			providerConfig:            openStackConfig,
+			openStack:                 &imv1.OpenStackProvider{ExposureClassName: "from-runtime"},
+			expectedExposureClassName: ptr.To("from-runtime"),
 		},
 		{
-			name:                         "ExposureClassName set for OpenStack",
-			providerType:                 hyperscaler.TypeOpenStack,
-			expectedExposureClassNameSet: true,
+			name:                      "ExposureClassName of the existing shoot is kept",
+			providerType:              hyperscaler.TypeOpenStack,
+			region:                    "eu-de-2",
+			providerConfig:            openStackConfig,
+			openStack:                 &imv1.OpenStackProvider{ExposureClassName: "from-runtime"},
+			existingExposureClassName: ptr.To("existing"),
+			expectedExposureClassName: ptr.To("existing"),
 		},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -31,9 +77,11 @@ func TestExtendWithExposureClassName(t *testing.T) {
 			runtime := imv1.Runtime{
 				Spec: imv1.RuntimeSpec{
 					Shoot: imv1.RuntimeShoot{
-						Name: "myshoot",
+						Name:   "myshoot",
+						Region: testCase.region,
 						Provider: imv1.Provider{
-							Type: testCase.providerType,
+							Type:      testCase.providerType,
+							OpenStack: testCase.openStack,
 						},
 					},
 				},
@@ -41,13 +89,31 @@ func TestExtendWithExposureClassName(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("test", "dev")
 
 			// when
-			err := ExtendWithExposureClassName(runtime, &shoot)
-
-			exposureClassNameSet := (shoot.Spec.ExposureClassName != nil) && (*shoot.Spec.ExposureClassName == "converged-cloud-internet")
+			err := NewExposureClassNameExtender(testCase.providerConfig, testCase.existingExposureClassName)(runtime, &shoot)
 
 			// then
 			require.NoError(t, err)
-			assert.Equal(t, testCase.expectedExposureClassNameSet, exposureClassNameSet)
+			assert.Equal(t, testCase.expectedExposureClassName, shoot.Spec.ExposureClassName)
 		})
 	}
+	t.Run("Return error for unknown provider", func(t *testing.T) {
+		// given
+		runtime := imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Name: "myshoot",
+					Provider: imv1.Provider{
+						Type: "unknown",
+					},
+				},
+			},
+		}
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewExposureClassNameExtender(config.ProviderConfig{}, nil)(runtime, &shoot)
+
+		// then
+		require.Error(t, err)
+	})
 }
@@ -0,0 +1,82 @@
+package extensions
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	ACLExtensionType = "acl"
+	aclActionAllow   = "ALLOW"
+	aclTypeRemoteIP  = "remote_ip"
+)
+
+type ACLExtensionConfig struct {
+	// Rule restricts the access to the API server of the shoot
+	Rule ACLRule `json:"rule"`
+}
+
+type ACLRule struct {
+	// Action is applied to the requests from the CIDRs
+	Action string `json:"action"`
+	// Type of the source of the requests matched with the CIDRs
+	Type string `json:"type"`
+	// Cidrs of the sources of the requests
+	Cidrs []string `json:"cidrs"`
+}
+
+// NewACLExtension allows the access to the API server only from the CIDRs of the Runtime and the egress CIDRs of KCP.
+// The KCP egress CIDRs are required, otherwise the infrastructure manager could not reach the API server of the runtime.
+func NewACLExtension(apiServerACL imv1.APIServerACL, kcpEgressCIDRs []string) (*gardener.Extension, error) {
+	if len(kcpEgressCIDRs) == 0 {
+		return nil, errors.New("the API server ACL cannot be set because the KCP egress CIDRs are not configured")
+	}
+
+	cidrs := make([]string, 0, len(apiServerACL.AllowedCIDRs)+len(kcpEgressCIDRs))
+	for _, cidr := range append(slices.Clone(apiServerACL.AllowedCIDRs), kcpEgressCIDRs...) {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, fmt.Errorf("invalid API server ACL CIDR %s: %w", cidr, err)
+		}
+
+		if !slices.Contains(cidrs, cidr) {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+
+	cfg := ACLExtensionConfig{
+		Rule: ACLRule{
+			Action: aclActionAllow,
+			Type:   aclTypeRemoteIP,
+			Cidrs:  cidrs,
+		},
+	}
+	var buffer bytes.Buffer
+	if err := json.NewEncoder(&buffer).Encode(&cfg); err != nil {
+		return nil, err
+	}
+
+	return &gardener.Extension{
+		Type: ACLExtensionType,
+		ProviderConfig: &runtime.RawExtension{
+			Raw: buffer.Bytes(),
+		},
+		Disabled: ptr.To(false),
+	}, nil
+}
+
+// NewDisabledACLExtension opens the access to the API server of the shoot on which the ACL was set before
+func NewDisabledACLExtension() (*gardener.Extension, error) {
+	return &gardener.Extension{
+		Type:     ACLExtensionType,
+		Disabled: ptr.To(true),
+	}, nil
+}
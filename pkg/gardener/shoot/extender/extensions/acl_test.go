@@ -0,0 +1,143 @@
+package extensions
+
+import (
+	"encoding/json"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestACLExtension(t *testing.T) {
+	kcpEgressCIDRs := []string{"10.10.10.0/24", "10.20.20.20/32"}
+
+	t.Run("Should allow the CIDRs of the Runtime and the KCP egress CIDRs", func(t *testing.T) {
+		// when
+		extension, err := NewACLExtension(imv1.APIServerACL{AllowedCIDRs: []string{"192.168.0.0/16", "10.20.20.20/32"}}, kcpEgressCIDRs)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ACLExtensionType, extension.Type)
+		assert.Equal(t, ptr.To(false), extension.Disabled)
+		assert.Equal(t, ACLRule{
+			Action: "ALLOW",
+			Type:   "remote_ip",
+			Cidrs:  []string{"192.168.0.0/16", "10.20.20.20/32", "10.10.10.0/24"},
+		}, aclRuleOf(t, *extension))
+	})
+
+	t.Run("Should return error if the KCP egress CIDRs are not configured", func(t *testing.T) {
+		// when
+		_, err := NewACLExtension(imv1.APIServerACL{AllowedCIDRs: []string{"192.168.0.0/16"}}, nil)
+
+		// then
+		require.EqualError(t, err, "the API server ACL cannot be set because the KCP egress CIDRs are not configured")
+	})
+
+	t.Run("Should return error for invalid CIDR", func(t *testing.T) {
+		// when
+		_, err := NewACLExtension(imv1.APIServerACL{AllowedCIDRs: []string{"192.168.0.1"}}, kcpEgressCIDRs)
+
+		// then
+		require.ErrorContains(t, err, "invalid API server ACL CIDR 192.168.0.1")
+	})
+
+	converterConfig := config.ConverterConfig{
+		APIServerACL: config.APIServerACLConfig{KCPEgressCIDRs: kcpEgressCIDRs},
+	}
+
+	runtimeWithACL := fixRuntimeCRForExtensionExtenderTests(true)
+	runtimeWithACL.Spec.Security.APIServerACL = &imv1.APIServerACL{AllowedCIDRs: []string{"192.168.0.0/16"}}
+
+	t.Run("Should add the ACL extension to the new shoot", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+
+		// when
+		err := NewExtensionsExtenderForCreate(converterConfig, auditlogs.AuditLogData{})(runtimeWithACL, shoot)
+
+		// then
+		require.NoError(t, err)
+		extension := findExtension(shoot.Spec.Extensions, ACLExtensionType)
+		require.NotNil(t, extension)
+		assert.Equal(t, []string{"192.168.0.0/16", "10.10.10.0/24", "10.20.20.20/32"}, aclRuleOf(t, *extension).Cidrs)
+	})
+
+	t.Run("Should not add the ACL extension to the new shoot if the Runtime has no ACL", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+
+		// when
+		err := NewExtensionsExtenderForCreate(converterConfig, auditlogs.AuditLogData{})(fixRuntimeCRForExtensionExtenderTests(true), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, findExtension(shoot.Spec.Extensions, ACLExtensionType))
+	})
+
+	t.Run("Should update the ACL extension of the existing shoot", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+		previousExtensions := append(fixAllExtensionsOnTheShoot(), gardener.Extension{Type: ACLExtensionType, Disabled: ptr.To(true)})
+
+		// when
+		err := NewExtensionsExtenderForPatch(converterConfig, auditlogs.AuditLogData{}, previousExtensions)(runtimeWithACL, shoot)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, shoot.Spec.Extensions, len(previousExtensions))
+		extension := shoot.Spec.Extensions[len(previousExtensions)-1]
+		assert.Equal(t, ptr.To(false), extension.Disabled)
+		assert.Equal(t, []string{"192.168.0.0/16", "10.10.10.0/24", "10.20.20.20/32"}, aclRuleOf(t, extension).Cidrs)
+	})
+
+	t.Run("Should disable the ACL extension of the existing shoot if the Runtime has no ACL", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+		aclExtension, err := NewACLExtension(*runtimeWithACL.Spec.Security.APIServerACL, kcpEgressCIDRs)
+		require.NoError(t, err)
+		previousExtensions := append(fixAllExtensionsOnTheShoot(), *aclExtension)
+
+		// when
+		err = NewExtensionsExtenderForPatch(converterConfig, auditlogs.AuditLogData{}, previousExtensions)(fixRuntimeCRForExtensionExtenderTests(true), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.Extension{Type: ACLExtensionType, Disabled: ptr.To(true)}, findExtension(shoot.Spec.Extensions, ACLExtensionType))
+	})
+
+	t.Run("Should not add the ACL extension to the existing shoot if the Runtime has no ACL", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+
+		// when
+		err := NewExtensionsExtenderForPatch(converterConfig, auditlogs.AuditLogData{}, fixAllExtensionsOnTheShoot())(fixRuntimeCRForExtensionExtenderTests(true), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, findExtension(shoot.Spec.Extensions, ACLExtensionType))
+	})
+}
+
+func aclRuleOf(t *testing.T, extension gardener.Extension) ACLRule {
+	require.NotNil(t, extension.ProviderConfig)
+
+	var aclConfig ACLExtensionConfig
+	require.NoError(t, json.Unmarshal(extension.ProviderConfig.Raw, &aclConfig))
+
+	return aclConfig.Rule
+}
+
+func findExtension(extensions []gardener.Extension, extensionType string) *gardener.Extension {
+	for _, extension := range extensions {
+		if extension.Type == extensionType {
+			return &extension
+		}
+	}
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 type CreateExtensionFunc func(runtime imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error)
@@ -17,95 +19,198 @@ type Extension struct {
 	Create CreateExtensionFunc
 }
 
+// DefaultExtensions are the built-in extensions with their default flags, they are used if they are not configured in the converter configuration
+func DefaultExtensions() []config.ExtensionConfig {
+	return []config.ExtensionConfig{
+		{Type: NetworkFilterType, Create: true, Patch: true},
+		{Type: CertExtensionType, Create: true},
+		{Type: DNSExtensionType, Create: true},
+		{Type: OidcExtensionType, Create: true},
+		{Type: AuditlogExtensionType, Create: true, Patch: true},
+		{Type: ACLExtensionType, Create: true, Patch: true},
+	}
+}
+
+// BuiltInTypes returns the types of the extensions created by the built-in handlers
+func BuiltInTypes() []string {
+	var types []string
+	for _, extension := range DefaultExtensions() {
+		types = append(types, extension.Type)
+	}
+	return types
+}
+
 func NewExtensionsExtenderForCreate(config config.ConverterConfig, auditLogData auditlogs.AuditLogData) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-	return newExtensionsExtender([]Extension{
-		{
-			Type: NetworkFilterType,
-			Create: func(runtime imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
-				return NewNetworkFilterExtension(!runtime.Spec.Security.Networking.Filter.Egress.Enabled)
-			},
+	extensionsToApply := extensionsFromCatalog(config, createHandlers(config, auditLogData), isCreated)
+
+	return newExtensionsExtender(extensionsToApply, nil)
+}
+
+func NewExtensionsExtenderForPatch(config config.ConverterConfig, auditLogData auditlogs.AuditLogData, extensionsOnTheShoot []gardener.Extension) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	extensionsToApply := extensionsFromCatalog(config, patchHandlers(config, auditLogData), isPatched)
+
+	return newExtensionsExtender(extensionsToApply, extensionsOnTheShoot)
+}
+
+func isCreated(extensionConfig config.ExtensionConfig) bool {
+	return extensionConfig.Create
+}
+
+func isPatched(extensionConfig config.ExtensionConfig) bool {
+	return extensionConfig.Patch
+}
+
+func createHandlers(config config.ConverterConfig, auditLogData auditlogs.AuditLogData) map[string]CreateExtensionFunc {
+	return map[string]CreateExtensionFunc{
+		NetworkFilterType: func(runtime imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
+			return NewNetworkFilterExtension(!runtime.Spec.Security.Networking.Filter.Egress.Enabled)
 		},
-		{
-			Type: CertExtensionType,
-			Create: func(_ imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
-				return NewCertExtension()
-			},
+		CertExtensionType: func(_ imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
+			return NewCertExtension()
 		},
-		{
-			Type: DNSExtensionType,
-			Create: func(_ imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
-				return NewDNSExtension(shoot.Name, config.DNS.SecretName, config.DNS.DomainPrefix, config.DNS.ProviderType)
-			},
+		DNSExtensionType: func(_ imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
+			return NewDNSExtension(shoot.Name, config.DNS.SecretName, config.DNS.DomainPrefix, config.DNS.ProviderType)
 		},
-		{
-			Type: OidcExtensionType,
-			Create: func(_ imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
-				return NewOIDCExtension()
-			},
+		OidcExtensionType: func(_ imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
+			return NewOIDCExtension()
 		},
-		{
-			Type: AuditlogExtensionType,
-			Create: func(_ imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
-				if auditLogData == (auditlogs.AuditLogData{}) {
-					return nil, nil
-				}
-
-				return NewAuditLogExtension(auditLogData)
-			},
+		AuditlogExtensionType: func(_ imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
+			if auditLogData == (auditlogs.AuditLogData{}) {
+				return nil, nil
+			}
+
+			return NewAuditLogExtension(auditLogData)
+		},
+		ACLExtensionType: func(runtime imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
+			if runtime.Spec.Security.APIServerACL == nil {
+				return nil, nil
+			}
+
+			return NewACLExtension(*runtime.Spec.Security.APIServerACL, config.APIServerACL.KCPEgressCIDRs)
 		},
-	}, nil)
+	}
 }
 
-func NewExtensionsExtenderForPatch(auditLogData auditlogs.AuditLogData, extensionsOnTheShoot []gardener.Extension) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-	return newExtensionsExtender([]Extension{
-		{
-			AuditlogExtensionType,
-			func(_ imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
-				if auditLogData == (auditlogs.AuditLogData{}) {
-					return nil, nil
-				}
-
-				newAuditLogExtension, err := NewAuditLogExtension(auditLogData)
-				if err != nil {
-					return nil, err
-				}
-
-				auditLogIndex := slices.IndexFunc(shoot.Spec.Extensions, func(e gardener.Extension) bool {
-					return e.Type == AuditlogExtensionType
-				})
-
-				if auditLogIndex == -1 {
-					return newAuditLogExtension, nil
-				}
-				var existingAuditLogConfig AuditlogExtensionConfig
-				if err := json.Unmarshal(shoot.Spec.Extensions[auditLogIndex].ProviderConfig.Raw, &existingAuditLogConfig); err != nil {
-					return nil, err
-				}
-
-				var newAuditLogConfig AuditlogExtensionConfig
-				if err := json.Unmarshal(newAuditLogExtension.ProviderConfig.Raw, &newAuditLogConfig); err != nil {
-					return nil, err
-				}
-
-				if newAuditLogConfig != existingAuditLogConfig {
-					return newAuditLogExtension, nil
-				}
+// patchHandlers update the extensions of the existing shoot, the extensions without the special update logic are created like for the new shoot
+func patchHandlers(config config.ConverterConfig, auditLogData auditlogs.AuditLogData) map[string]CreateExtensionFunc {
+	handlers := createHandlers(config, auditLogData)
 
-				return nil, nil
-			},
-		},
-		{
-			Type: NetworkFilterType,
-			Create: func(runtime imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
-				return NewNetworkFilterExtension(!runtime.Spec.Security.Networking.Filter.Egress.Enabled)
-			},
-		},
-	}, extensionsOnTheShoot)
+	handlers[AuditlogExtensionType] = func(_ imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
+		if auditLogData == (auditlogs.AuditLogData{}) {
+			return nil, nil
+		}
+
+		newAuditLogExtension, err := NewAuditLogExtension(auditLogData)
+		if err != nil {
+			return nil, err
+		}
+
+		auditLogIndex := slices.IndexFunc(shoot.Spec.Extensions, func(e gardener.Extension) bool {
+			return e.Type == AuditlogExtensionType
+		})
+
+		if auditLogIndex == -1 {
+			return newAuditLogExtension, nil
+		}
+		var existingAuditLogConfig AuditlogExtensionConfig
+		if err := json.Unmarshal(shoot.Spec.Extensions[auditLogIndex].ProviderConfig.Raw, &existingAuditLogConfig); err != nil {
+			return nil, err
+		}
+
+		var newAuditLogConfig AuditlogExtensionConfig
+		if err := json.Unmarshal(newAuditLogExtension.ProviderConfig.Raw, &newAuditLogConfig); err != nil {
+			return nil, err
+		}
+
+		if newAuditLogConfig != existingAuditLogConfig {
+			return newAuditLogExtension, nil
+		}
+
+		return nil, nil
+	}
+
+	handlers[ACLExtensionType] = func(runtime imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
+		if runtime.Spec.Security.APIServerACL != nil {
+			return NewACLExtension(*runtime.Spec.Security.APIServerACL, config.APIServerACL.KCPEgressCIDRs)
+		}
+
+		hasACL := slices.ContainsFunc(shoot.Spec.Extensions, func(e gardener.Extension) bool {
+			return e.Type == ACLExtensionType
+		})
+		if hasACL {
+			return NewDisabledACLExtension()
+		}
+
+		return nil, nil
+	}
+
+	return handlers
+}
+
+// extensionsFromCatalog returns the extensions selected from the catalog in the configured order.
+// The built-in extensions not configured are selected with their default flags before the configured ones.
+// The extensions without the built-in handler are created from the configured providerConfig.
+func extensionsFromCatalog(cfg config.ConverterConfig, handlers map[string]CreateExtensionFunc, selected func(config.ExtensionConfig) bool) []Extension {
+	var catalog []config.ExtensionConfig
+	for _, builtIn := range DefaultExtensions() {
+		configured := slices.ContainsFunc(cfg.Extensions, func(extensionConfig config.ExtensionConfig) bool {
+			return extensionConfig.Type == builtIn.Type
+		})
+		if !configured {
+			catalog = append(catalog, builtIn)
+		}
+	}
+	catalog = append(catalog, cfg.Extensions...)
+
+	var extensionsToApply []Extension
+	for _, extensionConfig := range catalog {
+		if !selected(extensionConfig) {
+			continue
+		}
+
+		create, ok := handlers[extensionConfig.Type]
+		if !ok {
+			create = newConfiguredExtensionFunc(extensionConfig)
+		}
+
+		extensionsToApply = append(extensionsToApply, Extension{
+			Type:   extensionConfig.Type,
+			Create: enabledForPlanAndProvider(extensionConfig, create),
+		})
+	}
+
+	return extensionsToApply
+}
+
+func enabledForPlanAndProvider(extensionConfig config.ExtensionConfig, create CreateExtensionFunc) CreateExtensionFunc {
+	return func(runtime imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
+		if !extensionConfig.Matches(runtime.Labels[imv1.LabelKymaBrokerPlanName], runtime.Spec.Shoot.Provider.Type) {
+			return nil, nil
+		}
+
+		return create(runtime, shoot)
+	}
+}
+
+func newConfiguredExtensionFunc(extensionConfig config.ExtensionConfig) CreateExtensionFunc {
+	return func(_ imv1.Runtime, _ gardener.Shoot) (*gardener.Extension, error) {
+		extension := &gardener.Extension{
+			Type:     extensionConfig.Type,
+			Disabled: ptr.To(false),
+		}
+
+		if len(extensionConfig.ProviderConfig) > 0 {
+			extension.ProviderConfig = &apimachineryRuntime.RawExtension{Raw: slices.Clone(extensionConfig.ProviderConfig)}
+		}
+
+		return extension, nil
+	}
 }
 
 func newExtensionsExtender(extensionsToApply []Extension, currentGardenerExtensions []gardener.Extension) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-		shoot.Spec.Extensions = currentGardenerExtensions
+		// the extensions of the existing shoot are copied, so they can still be compared with the converted ones
+		shoot.Spec.Extensions = slices.Clone(currentGardenerExtensions)
 
 		for _, ext := range extensionsToApply {
 			gardenerExtension, err := ext.Create(runtime, *shoot)
@@ -181,7 +181,7 @@ func TestNewExtensionsExtenderForPatch(t *testing.T) {
 
 			isEmptyAuditLogData := testCase.inputAuditLogData == (auditlogs.AuditLogData{})
 
-			extender := NewExtensionsExtenderForPatch(testCase.inputAuditLogData, testCase.previousExtensions)
+			extender := NewExtensionsExtenderForPatch(config.ConverterConfig{}, testCase.inputAuditLogData, testCase.previousExtensions)
 			orderMap := getExpectedExtensionsOrderMapForPatch(testCase.previousExtensions, isEmptyAuditLogData)
 
 			err := extender(runtime, shoot)
@@ -340,9 +340,9 @@ func getExpectedExtensionsOrderMapForPatch(extensions []gardener.Extension, empt
 	}
 
 	if len(extensions) == 3 {
-		// add missing two at the end
-		extensionOrderMap[AuditlogExtensionType] = 3
-		extensionOrderMap[NetworkFilterType] = 4
+		// add missing two at the end in the order of the extensions catalog
+		extensionOrderMap[NetworkFilterType] = 3
+		extensionOrderMap[AuditlogExtensionType] = 4
 	}
 
 	return extensionOrderMap
@@ -460,3 +460,115 @@ func fixRuntimeCRForExtensionExtenderTests(networkFilterEnabled bool) imv1.Runti
 
 	return runtime
 }
+
+func TestExtensionsCatalog(t *testing.T) {
+	registryCacheConfig := json.RawMessage(`{"apiVersion":"registry.extensions.gardener.cloud/v1alpha3","kind":"RegistryConfig"}`)
+	converterConfig := config.ConverterConfig{
+		Extensions: []config.ExtensionConfig{
+			{Type: NetworkFilterType, Create: true, Patch: true},
+			{Type: "shoot-lakom-service", Create: true},
+			{Type: "registry-cache", ProviderConfig: registryCacheConfig, Create: true, Patch: true, Plans: []string{"aws"}, Providers: []string{"aws"}},
+		},
+	}
+
+	fixRuntime := func(plan, provider string) imv1.Runtime {
+		runtime := fixRuntimeCRForExtensionExtenderTests(true)
+		runtime.Labels = map[string]string{imv1.LabelKymaBrokerPlanName: plan}
+		runtime.Spec.Shoot.Provider.Type = provider
+		return runtime
+	}
+
+	t.Run("Should create only the extensions from the catalog enabled for the plan and provider", func(t *testing.T) {
+		for _, testCase := range []struct {
+			plan          string
+			provider      string
+			expectedTypes []string
+		}{
+			{"aws", "aws", []string{CertExtensionType, DNSExtensionType, OidcExtensionType, NetworkFilterType, "shoot-lakom-service", "registry-cache"}},
+			{"trial", "aws", []string{CertExtensionType, DNSExtensionType, OidcExtensionType, NetworkFilterType, "shoot-lakom-service"}},
+			{"aws", "gcp", []string{CertExtensionType, DNSExtensionType, OidcExtensionType, NetworkFilterType, "shoot-lakom-service"}},
+		} {
+			// given
+			shoot := &gardener.Shoot{}
+
+			// when
+			err := NewExtensionsExtenderForCreate(converterConfig, auditlogs.AuditLogData{})(fixRuntime(testCase.plan, testCase.provider), shoot)
+
+			// then
+			require.NoError(t, err)
+			var types []string
+			for _, ext := range shoot.Spec.Extensions {
+				types = append(types, ext.Type)
+			}
+			assert.Equal(t, testCase.expectedTypes, types)
+		}
+	})
+
+	t.Run("Should create the configured extension with the providerConfig from the catalog", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+
+		// when
+		err := NewExtensionsExtenderForCreate(converterConfig, auditlogs.AuditLogData{})(fixRuntime("aws", "aws"), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gardener.Extension{
+			Type:     "shoot-lakom-service",
+			Disabled: ptr.To(false),
+		}, shoot.Spec.Extensions[4])
+		assert.Equal(t, gardener.Extension{
+			Type:           "registry-cache",
+			Disabled:       ptr.To(false),
+			ProviderConfig: &runtime.RawExtension{Raw: registryCacheConfig},
+		}, shoot.Spec.Extensions[5])
+	})
+
+	t.Run("Should keep the built-in extensions not listed in the catalog and skip the ones disabled there", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+		catalogConfig := config.ConverterConfig{
+			Extensions: []config.ExtensionConfig{
+				{Type: CertExtensionType},
+				{Type: "shoot-lakom-service", Create: true},
+			},
+		}
+
+		// when
+		err := NewExtensionsExtenderForCreate(catalogConfig, auditlogs.AuditLogData{})(fixRuntime("aws", "aws"), shoot)
+
+		// then
+		require.NoError(t, err)
+		var types []string
+		for _, ext := range shoot.Spec.Extensions {
+			types = append(types, ext.Type)
+		}
+		assert.Equal(t, []string{NetworkFilterType, DNSExtensionType, OidcExtensionType, "shoot-lakom-service"}, types)
+	})
+
+	t.Run("Should update only the extensions from the catalog patched on the existing shoot", func(t *testing.T) {
+		// given
+		shoot := &gardener.Shoot{}
+		previousExtensions := []gardener.Extension{
+			{Type: "registry-cache", Disabled: ptr.To(true)},
+			{Type: "shoot-lakom-service", Disabled: ptr.To(true)},
+			{Type: CertExtensionType},
+		}
+
+		// when
+		err := NewExtensionsExtenderForPatch(converterConfig, auditlogs.AuditLogData{}, previousExtensions)(fixRuntime("aws", "aws"), shoot)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, shoot.Spec.Extensions, 4)
+		assert.Equal(t, gardener.Extension{
+			Type:           "registry-cache",
+			Disabled:       ptr.To(false),
+			ProviderConfig: &runtime.RawExtension{Raw: registryCacheConfig},
+		}, shoot.Spec.Extensions[0])
+		assert.Equal(t, gardener.Extension{Type: "shoot-lakom-service", Disabled: ptr.To(true)}, shoot.Spec.Extensions[1])
+		assert.Equal(t, gardener.Extension{Type: CertExtensionType}, shoot.Spec.Extensions[2])
+		assert.Equal(t, NetworkFilterType, shoot.Spec.Extensions[3].Type)
+		assert.Equal(t, gardener.Extension{Type: "registry-cache", Disabled: ptr.To(true)}, previousExtensions[0], "the extensions of the existing shoot must not be modified")
+	})
+}
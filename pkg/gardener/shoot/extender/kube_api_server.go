@@ -0,0 +1,27 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+// NewKubeAPIServerExtender sets the admission plugins, request limits, event TTL and anonymous authentication of the API server.
+// The settings are merged into the API server config of the shoot, so the OIDC and audit log settings set by other extenders are kept.
+// The anonymous authentication is set only if the Runtime sets it, otherwise it is defaulted by Gardener.
+func NewKubeAPIServerExtender() func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		apiServer := runtime.Spec.Shoot.Kubernetes.KubeAPIServer
+
+		if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{}
+		}
+
+		kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+		kubeAPIServer.AdmissionPlugins = apiServer.AdmissionPlugins
+		kubeAPIServer.Requests = apiServer.Requests
+		kubeAPIServer.EventTTL = apiServer.EventTTL
+		kubeAPIServer.EnableAnonymousAuthentication = apiServer.EnableAnonymousAuthentication
+
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package extender
+
+import (
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestKubeAPIServerExtender(t *testing.T) {
+	t.Run("Should set the API server settings of the Runtime and keep the OIDC and audit log settings", func(t *testing.T) {
+		// given
+		apiServer := imv1.APIServer{
+			AdmissionPlugins: []gardener.AdmissionPlugin{
+				{Name: "PodNodeSelector", Disabled: ptr.To(true)},
+			},
+			Requests: &gardener.APIServerRequests{
+				MaxMutatingInflight:    ptr.To(int32(400)),
+				MaxNonMutatingInflight: ptr.To(int32(800)),
+			},
+			EventTTL:                      &metav1.Duration{Duration: 30 * time.Minute},
+			EnableAnonymousAuthentication: ptr.To(true),
+		}
+		runtime := imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Kubernetes: imv1.Kubernetes{KubeAPIServer: apiServer},
+				},
+			},
+		}
+
+		oidcConfig := &gardener.OIDCConfig{ClientID: ptr.To("client-id")}
+		auditConfig := &gardener.AuditConfig{
+			AuditPolicy: &gardener.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "policy-config-map"}},
+		}
+		shoot := fixEmptyGardenerShoot("test", "dev")
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{
+			OIDCConfig:  oidcConfig,
+			AuditConfig: auditConfig,
+		}
+
+		// when
+		err := NewKubeAPIServerExtender()(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.KubeAPIServerConfig{
+			OIDCConfig:                    oidcConfig,
+			AuditConfig:                   auditConfig,
+			AdmissionPlugins:              apiServer.AdmissionPlugins,
+			Requests:                      apiServer.Requests,
+			EventTTL:                      apiServer.EventTTL,
+			EnableAnonymousAuthentication: ptr.To(true),
+		}, shoot.Spec.Kubernetes.KubeAPIServer)
+	})
+
+	t.Run("Should not set the anonymous authentication if not set in the Runtime", func(t *testing.T) {
+		// given
+		runtime := imv1.Runtime{}
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewKubeAPIServerExtender()(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.KubeAPIServerConfig{}, shoot.Spec.Kubernetes.KubeAPIServer)
+	})
+}
@@ -0,0 +1,46 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+)
+
+// NewKubernetesComponentsExtender sets the cluster autoscaler, vertical pod autoscaler and kube-proxy settings of the shoot.
+// The settings of the Runtime take precedence over the ones configured for its plan, the settings not set anywhere are defaulted by Gardener.
+func NewKubernetesComponentsExtender(componentsConfig config.KubernetesComponentsConfig) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		kubernetes := runtime.Spec.Shoot.Kubernetes
+		settings := componentsConfig.ForPlan(runtime.Labels[imv1.LabelKymaBrokerPlanName]).Merge(config.KubernetesComponentsSettings{
+			ClusterAutoscaler:     kubernetes.ClusterAutoscaler,
+			VerticalPodAutoscaler: kubernetes.VerticalPodAutoscaler,
+			KubeProxy:             kubernetes.KubeProxy,
+		})
+
+		if clusterAutoscaler := settings.ClusterAutoscaler; clusterAutoscaler != nil {
+			shoot.Spec.Kubernetes.ClusterAutoscaler = &gardener.ClusterAutoscaler{
+				Expander:                      clusterAutoscaler.Expander,
+				ScaleDownDelayAfterAdd:        clusterAutoscaler.ScaleDownDelayAfterAdd,
+				ScaleDownDelayAfterDelete:     clusterAutoscaler.ScaleDownDelayAfterDelete,
+				ScaleDownDelayAfterFailure:    clusterAutoscaler.ScaleDownDelayAfterFailure,
+				ScaleDownUnneededTime:         clusterAutoscaler.ScaleDownUnneededTime,
+				ScaleDownUtilizationThreshold: clusterAutoscaler.ScaleDownUtilizationThreshold,
+				MaxNodeProvisionTime:          clusterAutoscaler.MaxNodeProvisionTime,
+			}
+		}
+
+		if verticalPodAutoscaler := settings.VerticalPodAutoscaler; verticalPodAutoscaler != nil && verticalPodAutoscaler.Enabled != nil {
+			shoot.Spec.Kubernetes.VerticalPodAutoscaler = &gardener.VerticalPodAutoscaler{
+				Enabled: *verticalPodAutoscaler.Enabled,
+			}
+		}
+
+		if kubeProxy := settings.KubeProxy; kubeProxy != nil && kubeProxy.Mode != nil {
+			shoot.Spec.Kubernetes.KubeProxy = &gardener.KubeProxyConfig{
+				Mode: kubeProxy.Mode,
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package extender
+
+import (
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestKubernetesComponentsExtender(t *testing.T) {
+	componentsConfig := config.KubernetesComponentsConfig{
+		KubernetesComponentsSettings: config.KubernetesComponentsSettings{
+			ClusterAutoscaler: &imv1.ClusterAutoscaler{
+				Expander:                      ptr.To(gardener.ClusterAutoscalerExpanderLeastWaste),
+				ScaleDownUtilizationThreshold: ptr.To(0.5),
+			},
+			VerticalPodAutoscaler: &imv1.VerticalPodAutoscaler{Enabled: ptr.To(true)},
+		},
+		Plans: map[string]config.KubernetesComponentsSettings{
+			"trial": {
+				ClusterAutoscaler: &imv1.ClusterAutoscaler{
+					ScaleDownUnneededTime: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range []struct {
+		name                          string
+		plan                          string
+		kubernetes                    imv1.Kubernetes
+		expectedClusterAutoscaler     *gardener.ClusterAutoscaler
+		expectedVerticalPodAutoscaler *gardener.VerticalPodAutoscaler
+		expectedKubeProxy             *gardener.KubeProxyConfig
+	}{
+		{
+			name: "Settings taken from the defaults",
+			plan: "aws",
+			expectedClusterAutoscaler: &gardener.ClusterAutoscaler{
+				Expander:                      ptr.To(gardener.ClusterAutoscalerExpanderLeastWaste),
+				ScaleDownUtilizationThreshold: ptr.To(0.5),
+			},
+			expectedVerticalPodAutoscaler: &gardener.VerticalPodAutoscaler{Enabled: true},
+		},
+		{
+			name: "Settings taken from the plan configuration",
+			plan: "trial",
+			expectedClusterAutoscaler: &gardener.ClusterAutoscaler{
+				Expander:                      ptr.To(gardener.ClusterAutoscalerExpanderLeastWaste),
+				ScaleDownUtilizationThreshold: ptr.To(0.5),
+				ScaleDownUnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			expectedVerticalPodAutoscaler: &gardener.VerticalPodAutoscaler{Enabled: true},
+		},
+		{
+			name: "Settings taken from the Runtime CR",
+			plan: "trial",
+			kubernetes: imv1.Kubernetes{
+				ClusterAutoscaler: &imv1.ClusterAutoscaler{
+					Expander:                   ptr.To(gardener.ClusterAutoscalerExpanderPriority),
+					ScaleDownDelayAfterAdd:     &metav1.Duration{Duration: time.Hour},
+					ScaleDownDelayAfterDelete:  &metav1.Duration{Duration: time.Minute},
+					ScaleDownDelayAfterFailure: &metav1.Duration{Duration: 3 * time.Minute},
+					MaxNodeProvisionTime:       &metav1.Duration{Duration: 20 * time.Minute},
+				},
+				VerticalPodAutoscaler: &imv1.VerticalPodAutoscaler{Enabled: ptr.To(false)},
+				KubeProxy:             &imv1.KubeProxy{Mode: ptr.To(gardener.ProxyModeIPVS)},
+			},
+			expectedClusterAutoscaler: &gardener.ClusterAutoscaler{
+				Expander:                      ptr.To(gardener.ClusterAutoscalerExpanderPriority),
+				ScaleDownDelayAfterAdd:        &metav1.Duration{Duration: time.Hour},
+				ScaleDownDelayAfterDelete:     &metav1.Duration{Duration: time.Minute},
+				ScaleDownDelayAfterFailure:    &metav1.Duration{Duration: 3 * time.Minute},
+				ScaleDownUtilizationThreshold: ptr.To(0.5),
+				ScaleDownUnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+				MaxNodeProvisionTime:          &metav1.Duration{Duration: 20 * time.Minute},
+			},
+			expectedVerticalPodAutoscaler: &gardener.VerticalPodAutoscaler{Enabled: false},
+			expectedKubeProxy:             &gardener.KubeProxyConfig{Mode: ptr.To(gardener.ProxyModeIPVS)},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			runtime := imv1.Runtime{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{imv1.LabelKymaBrokerPlanName: testCase.plan},
+				},
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Name:       "myshoot",
+						Kubernetes: testCase.kubernetes,
+					},
+				},
+			}
+			shoot := fixEmptyGardenerShoot("test", "dev")
+
+			// when
+			err := NewKubernetesComponentsExtender(componentsConfig)(runtime, &shoot)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedClusterAutoscaler, shoot.Spec.Kubernetes.ClusterAutoscaler)
+			assert.Equal(t, testCase.expectedVerticalPodAutoscaler, shoot.Spec.Kubernetes.VerticalPodAutoscaler)
+			assert.Equal(t, testCase.expectedKubeProxy, shoot.Spec.Kubernetes.KubeProxy)
+		})
+	}
+
+	t.Run("Should not set the settings if none are configured", func(t *testing.T) {
+		// given
+		runtime := imv1.Runtime{Spec: imv1.RuntimeSpec{Shoot: imv1.RuntimeShoot{Name: "myshoot"}}}
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewKubernetesComponentsExtender(config.KubernetesComponentsConfig{})(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.ClusterAutoscaler)
+		assert.Nil(t, shoot.Spec.Kubernetes.VerticalPodAutoscaler)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeProxy)
+	})
+}
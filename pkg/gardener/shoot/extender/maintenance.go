@@ -1,20 +1,115 @@
 package extender
 
 import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+	_ "time/tzdata" // the timezones of the maintenance time windows must be available in images without the timezone database
+
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"k8s.io/utils/ptr"
 )
 
-func NewMaintenanceExtender(enableKubernetesVersionAutoUpdate, enableMachineImageVersionAutoUpdate bool) func(runtime imv1.Runtime, shoot *gardener.Shoot) error { //nolint:revive
+const (
+	// the default time windows begin between 22:00 and 05:00 in steps of 15 minutes, so the last one ends at 06:00
+	defaultMaintenanceNightBegin = 22 * time.Hour
+	defaultMaintenanceSlots      = 29
+	defaultMaintenanceSlotLength = 15 * time.Minute
+	defaultMaintenanceDuration   = time.Hour
+	maintenanceTimeFormat        = "150405"
+	defaultTimezone              = "UTC"
+)
+
+// regionTimezones maps the parts of the region names of all providers to the timezones, the first matching entry is used
+var regionTimezones = []struct { //nolint:gochecknoglobals
+	keywords []string
+	timezone string
+}{
+	{keywords: []string{"africa", "af-"}, timezone: "Africa/Johannesburg"},
+	{keywords: []string{"me-", "ap-ae", "ap-sa", "uae", "qatar", "israel"}, timezone: "Asia/Dubai"},
+	{keywords: []string{"southamerica", "sa-", "brazil"}, timezone: "America/Sao_Paulo"},
+	{keywords: []string{"us-west", "westus"}, timezone: "America/Los_Angeles"},
+	{keywords: []string{"us-", "na-", "northamerica", "eastus", "centralus", "canada", "ca-"}, timezone: "America/New_York"},
+	{keywords: []string{"eu-", "europe", "uk", "france", "germany", "switzerland", "norway", "sweden", "poland", "italy", "spain"}, timezone: "Europe/Berlin"},
+	{keywords: []string{"ap-south-", "asia-south", "india"}, timezone: "Asia/Kolkata"},
+	{keywords: []string{"ap-northeast", "asia-northeast", "ap-jp", "japan", "korea"}, timezone: "Asia/Tokyo"},
+	{keywords: []string{"australia", "ap-southeast-2", "ap-au"}, timezone: "Australia/Sydney"},
+	{keywords: []string{"ap-", "asia", "singapore"}, timezone: "Asia/Singapore"},
+}
+
+// NewMaintenanceExtender sets the maintenance time window and the auto-update settings of the shoot.
+// The time window of the Runtime is set with the current offset of its timezone, the timezone of the region is used if it is not set.
+// The default time window is derived from the shoot name, so the shoots do not start their maintenance at the same time.
+// The time window of the existing shoot is kept when patching, unless the Runtime specifies the time window or the timezone.
+// The auto-update settings of the Runtime take precedence over the configured ones.
+func NewMaintenanceExtender(enableKubernetesVersionAutoUpdate, enableMachineImageVersionAutoUpdate bool, existingTimeWindow *gardener.MaintenanceTimeWindow) func(runtime imv1.Runtime, shoot *gardener.Shoot) error { //nolint:revive
 	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error { //nolint:revive
+		maintenance := runtime.Spec.Shoot.Maintenance
+		if maintenance == nil {
+			maintenance = &imv1.Maintenance{}
+		}
+
+		autoUpdate := &gardener.MaintenanceAutoUpdate{
+			KubernetesVersion:   enableKubernetesVersionAutoUpdate,
+			MachineImageVersion: ptr.To(enableMachineImageVersionAutoUpdate),
+		}
+		if maintenance.AutoUpdate != nil {
+			autoUpdate.KubernetesVersion = ptr.Deref(maintenance.AutoUpdate.KubernetesVersion, enableKubernetesVersionAutoUpdate)
+			autoUpdate.MachineImageVersion = ptr.To(ptr.Deref(maintenance.AutoUpdate.MachineImageVersion, enableMachineImageVersionAutoUpdate))
+		}
+
 		shoot.Spec.Maintenance = &gardener.Maintenance{
-			AutoUpdate: &gardener.MaintenanceAutoUpdate{
-				KubernetesVersion:   enableKubernetesVersionAutoUpdate,
-				MachineImageVersion: ptr.To(enableMachineImageVersionAutoUpdate),
-			},
+			AutoUpdate: autoUpdate,
+		}
+
+		if existingTimeWindow != nil && maintenance.TimeWindow == nil && maintenance.Timezone == nil {
+			shoot.Spec.Maintenance.TimeWindow = existingTimeWindow.DeepCopy()
+			return nil
+		}
+
+		timezone := ptr.Deref(maintenance.Timezone, regionTimezone(runtime.Spec.Shoot.Region))
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			return fmt.Errorf("invalid maintenance timezone %s: %w", timezone, err)
+		}
+
+		timeWindow := defaultTimeWindow(runtime.Spec.Shoot.Name)
+		if maintenance.TimeWindow != nil {
+			timeWindow = *maintenance.TimeWindow
+		}
+
+		offset := time.Now().In(location).Format("-0700")
+		shoot.Spec.Maintenance.TimeWindow = &gardener.MaintenanceTimeWindow{
+			Begin: timeWindow.Begin + offset,
+			End:   timeWindow.End + offset,
 		}
 
 		return nil
 	}
 }
+
+// defaultTimeWindow returns the one hour time window starting in the night slot selected by the hash of the shoot name
+func defaultTimeWindow(shootName string) imv1.MaintenanceTimeWindow {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(shootName))
+
+	begin := time.Time{}.Add(defaultMaintenanceNightBegin + time.Duration(hash.Sum32()%defaultMaintenanceSlots)*defaultMaintenanceSlotLength)
+	return imv1.MaintenanceTimeWindow{
+		Begin: begin.Format(maintenanceTimeFormat),
+		End:   begin.Add(defaultMaintenanceDuration).Format(maintenanceTimeFormat),
+	}
+}
+
+func regionTimezone(region string) string {
+	region = strings.ToLower(region)
+	for _, regionTimezone := range regionTimezones {
+		for _, keyword := range regionTimezone.keywords {
+			if strings.Contains(region, keyword) {
+				return regionTimezone.timezone
+			}
+		}
+	}
+	return defaultTimezone
+}
@@ -6,6 +6,8 @@ import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 )
 
 func TestMaintenanceExtender(t *testing.T) {
@@ -40,7 +42,7 @@ func TestMaintenanceExtender(t *testing.T) {
 			}
 
 			// when
-			extender := NewMaintenanceExtender(testCase.enableKubernetesVersionAutoUpdate, testCase.enableMachineImageVersionAutoUpdate)
+			extender := NewMaintenanceExtender(testCase.enableKubernetesVersionAutoUpdate, testCase.enableMachineImageVersionAutoUpdate, nil)
 			err := extender(runtimeShoot, &shoot)
 
 			// then
@@ -49,4 +51,150 @@ func TestMaintenanceExtender(t *testing.T) {
 			assert.Equal(t, testCase.enableMachineImageVersionAutoUpdate, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 		})
 	}
+
+	t.Run("Should override the configured auto-update settings with the Runtime ones", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("test", "dev")
+		runtime := fixMaintenanceRuntime("eu-central-1", &imv1.Maintenance{
+			AutoUpdate: &imv1.MaintenanceAutoUpdate{KubernetesVersion: ptr.To(false)},
+		})
+
+		// when
+		err := NewMaintenanceExtender(true, true, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion)
+		assert.True(t, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+	})
+
+	t.Run("Should set the time window of the Runtime in its timezone", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("test", "dev")
+		runtime := fixMaintenanceRuntime("eu-central-1", &imv1.Maintenance{
+			TimeWindow: &imv1.MaintenanceTimeWindow{Begin: "220000", End: "230000"},
+			Timezone:   ptr.To("Asia/Tokyo"),
+		})
+
+		// when
+		err := NewMaintenanceExtender(false, false, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.MaintenanceTimeWindow{Begin: "220000+0900", End: "230000+0900"}, shoot.Spec.Maintenance.TimeWindow)
+	})
+
+	t.Run("Should set the default time window in the timezone of the region", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("test", "dev")
+		runtime := fixMaintenanceRuntime("ap-northeast-1", nil)
+
+		// when
+		err := NewMaintenanceExtender(false, false, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.MaintenanceTimeWindow{Begin: "010000+0900", End: "020000+0900"}, shoot.Spec.Maintenance.TimeWindow)
+	})
+
+	t.Run("Should derive the default time window from the shoot name", func(t *testing.T) {
+		for shootName, expectedTimeWindow := range map[string]*gardener.MaintenanceTimeWindow{
+			"a":        {Begin: "221500+0000", End: "231500+0000"},
+			"b":        {Begin: "034500+0000", End: "044500+0000"},
+			"c-4d5e6f": {Begin: "000000+0000", End: "010000+0000"},
+			"c-1a2b3c": {Begin: "050000+0000", End: "060000+0000"},
+		} {
+			// given
+			shoot := fixEmptyGardenerShoot(shootName, "dev")
+			runtime := fixMaintenanceRuntime("unknown", nil)
+			runtime.Spec.Shoot.Name = shootName
+
+			// when
+			err := NewMaintenanceExtender(false, false, nil)(runtime, &shoot)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, expectedTimeWindow, shoot.Spec.Maintenance.TimeWindow, shootName)
+		}
+	})
+
+	t.Run("Should keep the time window of the existing shoot", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("test", "dev")
+		runtime := fixMaintenanceRuntime("eu-central-1", &imv1.Maintenance{
+			AutoUpdate: &imv1.MaintenanceAutoUpdate{MachineImageVersion: ptr.To(true)},
+		})
+		existingTimeWindow := &gardener.MaintenanceTimeWindow{Begin: "130000+0000", End: "140000+0000"}
+
+		// when
+		err := NewMaintenanceExtender(false, false, existingTimeWindow)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, existingTimeWindow, shoot.Spec.Maintenance.TimeWindow)
+	})
+
+	t.Run("Should replace the time window of the existing shoot with the Runtime one", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("test", "dev")
+		runtime := fixMaintenanceRuntime("eu-central-1", &imv1.Maintenance{
+			TimeWindow: &imv1.MaintenanceTimeWindow{Begin: "030000", End: "040000"},
+			Timezone:   ptr.To("UTC"),
+		})
+
+		// when
+		err := NewMaintenanceExtender(false, false, &gardener.MaintenanceTimeWindow{Begin: "130000+0000", End: "140000+0000"})(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.MaintenanceTimeWindow{Begin: "030000+0000", End: "040000+0000"}, shoot.Spec.Maintenance.TimeWindow)
+	})
+
+	t.Run("Should return error for invalid timezone", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("test", "dev")
+		runtime := fixMaintenanceRuntime("eu-central-1", &imv1.Maintenance{Timezone: ptr.To("Mars/Olympus")})
+
+		// when
+		err := NewMaintenanceExtender(false, false, nil)(runtime, &shoot)
+
+		// then
+		require.ErrorContains(t, err, "invalid maintenance timezone Mars/Olympus")
+	})
+}
+
+func TestRegionTimezone(t *testing.T) {
+	for region, expectedTimezone := range map[string]string{
+		"eu-central-1":    "Europe/Berlin",
+		"europe-west3":    "Europe/Berlin",
+		"westeurope":      "Europe/Berlin",
+		"us-east-1":       "America/New_York",
+		"us-west-2":       "America/Los_Angeles",
+		"westus2":         "America/Los_Angeles",
+		"ap-south-1":      "Asia/Kolkata",
+		"ap-northeast-1":  "Asia/Tokyo",
+		"ap-southeast-1":  "Asia/Singapore",
+		"ap-southeast-2":  "Australia/Sydney",
+		"australiaeast":   "Australia/Sydney",
+		"me-central2":     "Asia/Dubai",
+		"sa-east-1":       "America/Sao_Paulo",
+		"southamerica-e1": "America/Sao_Paulo",
+		"unknown":         "UTC",
+	} {
+		t.Run(region, func(t *testing.T) {
+			assert.Equal(t, expectedTimezone, regionTimezone(region))
+		})
+	}
+}
+
+func fixMaintenanceRuntime(region string, maintenance *imv1.Maintenance) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:        "test",
+				Region:      region,
+				Maintenance: maintenance,
+			},
+		},
+	}
 }
@@ -0,0 +1,22 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ExtendWithNetworking validates the Runtime networking of the new shoot, the networking fields are set by the converter.
+// The networking of the existing shoots is immutable, so it is not validated again when the shoot is patched.
+// The nodes CIDR must be large enough for the zone subnets of the providers carving them.
+func ExtendWithNetworking(runtime imv1.Runtime, _ *gardener.Shoot) error {
+	return validation.ValidateNetworking(runtime.Spec.Shoot, field.NewPath("spec", "shoot")).ToAggregate()
+}
+
+// NewProviderSettingsExtender validates the provider specific settings of the Runtime with the registered providers
+func NewProviderSettingsExtender(patch bool) func(runtime imv1.Runtime, _ *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, _ *gardener.Shoot) error {
+		return validation.ValidateProviderSettings(runtime.Spec.Shoot, field.NewPath("spec", "shoot"), patch).ToAggregate()
+	}
+}
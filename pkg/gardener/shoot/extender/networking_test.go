@@ -0,0 +1,92 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkingExtender(t *testing.T) {
+	t.Run("Should accept valid networking", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithNetworking(imv1.Networking{
+			Pods:     "100.64.0.0/12",
+			Nodes:    "10.250.0.0/16",
+			Services: "100.104.0.0/13",
+		})
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithNetworking(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should reject overlapping CIDRs", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithNetworking(imv1.Networking{
+			Pods:     "100.64.0.0/12",
+			Nodes:    "10.250.0.0/16",
+			Services: "100.64.0.0/13",
+		})
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithNetworking(runtime, &shoot)
+
+		// then
+		require.EqualError(t, err, `spec.shoot.networking.services: Invalid value: "100.64.0.0/13": overlaps with the pods CIDR 100.64.0.0/12`)
+	})
+
+	t.Run("Should reject nodes CIDR too small for the zone subnets", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithNetworking(imv1.Networking{
+			Nodes: "10.250.0.0/25",
+		})
+		runtime.Spec.Shoot.Provider = imv1.Provider{
+			Type:    hyperscaler.TypeAWS,
+			Workers: []gardener.Worker{{Name: "main", Zones: []string{"eu-central-1a"}}},
+		}
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithNetworking(runtime, &shoot)
+
+		// then
+		require.EqualError(t, err, `spec.shoot.networking.nodes: Invalid value: "10.250.0.0/25": nodes CIDR 10.250.0.0/25 is too small for the zone subnets: the smallest subnet would be /29, at most /28 is allowed, use a /24 or larger CIDR`)
+	})
+}
+
+func TestProviderSettingsExtender(t *testing.T) {
+	t.Run("Should reject invalid reference to an existing network", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithNetworking(imv1.Networking{
+			Nodes: "10.250.0.0/22",
+		})
+		runtime.Spec.Shoot.Provider = imv1.Provider{
+			Type: hyperscaler.TypeAWS,
+			AWS:  &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "vpc-0123456789abcdef0", CIDR: "10.251.0.0/16"}},
+		}
+		shoot := fixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewProviderSettingsExtender(false)(runtime, &shoot)
+
+		// then
+		require.EqualError(t, err, `spec.shoot.networking.nodes: Invalid value: "10.250.0.0/22": must be inside the CIDR 10.251.0.0/16 of the existing network`)
+	})
+}
+
+func fixRuntimeWithNetworking(networking imv1.Networking) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Networking: networking,
+			},
+		},
+	}
+}
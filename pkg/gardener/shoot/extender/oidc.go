@@ -33,18 +33,21 @@ func NewOidcExtender(oidcProvider config.OidcProvider) func(runtime imv1.Runtime
 	}
 }
 
+// setKubeAPIServerOIDCConfig sets only the OIDC config, the other API server settings of the shoot are kept
 func setKubeAPIServerOIDCConfig(shoot *gardener.Shoot, oidcConfig gardener.OIDCConfig) {
-	shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{
-		OIDCConfig: &gardener.OIDCConfig{
-			CABundle:       oidcConfig.CABundle,
-			ClientID:       oidcConfig.ClientID,
-			GroupsClaim:    oidcConfig.GroupsClaim,
-			GroupsPrefix:   oidcConfig.GroupsPrefix,
-			IssuerURL:      oidcConfig.IssuerURL,
-			RequiredClaims: oidcConfig.RequiredClaims,
-			SigningAlgs:    oidcConfig.SigningAlgs,
-			UsernameClaim:  oidcConfig.UsernameClaim,
-			UsernamePrefix: oidcConfig.UsernamePrefix,
-		},
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{}
+	}
+
+	shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = &gardener.OIDCConfig{
+		CABundle:       oidcConfig.CABundle,
+		ClientID:       oidcConfig.ClientID,
+		GroupsClaim:    oidcConfig.GroupsClaim,
+		GroupsPrefix:   oidcConfig.GroupsPrefix,
+		IssuerURL:      oidcConfig.IssuerURL,
+		RequiredClaims: oidcConfig.RequiredClaims,
+		SigningAlgs:    oidcConfig.SigningAlgs,
+		UsernameClaim:  oidcConfig.UsernameClaim,
+		UsernamePrefix: oidcConfig.UsernamePrefix,
 	}
 }
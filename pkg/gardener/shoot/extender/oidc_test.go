@@ -2,6 +2,7 @@ package extender
 
 import (
 	"testing"
+	"time"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
@@ -52,4 +53,19 @@ func TestOidcExtender(t *testing.T) {
 
 		assert.Equal(t, runtimeShoot.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig, *shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig)
 	})
+
+	t.Run("OIDC should be merged into the existing API server config", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("test", "kcp-system")
+		eventTTL := &metav1.Duration{Duration: time.Hour}
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{EventTTL: eventTTL}
+
+		// when
+		err := NewOidcExtender(defaultOidc)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, eventTTL, shoot.Spec.Kubernetes.KubeAPIServer.EventTTL)
+		assert.Equal(t, &defaultOidc.ClientID, shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientID)
+	})
 }
@@ -1,23 +1,29 @@
 package extender
 
 import (
-	"fmt"
 	"slices"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
-	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
-	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
-	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/gcp"
-	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/openstack"
+	// built-in hyperscaler providers
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/gcp"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/openstack"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // InfrastructureConfig and ControlPlaneConfig are generated unless they are specified in the RuntimeCR
-func NewProviderExtenderForCreateOperation(enableIMDSv2 bool, defMachineImgName, defMachineImgVer string) func(rt imv1.Runtime, shoot *gardener.Shoot) error {
+func NewProviderExtenderForCreateOperation(providerConfig config.ProviderConfig, defMachineImgName, defMachineImgVer string) func(rt imv1.Runtime, shoot *gardener.Shoot) error {
 	return func(rt imv1.Runtime, shoot *gardener.Shoot) error {
+		hyperscalerProvider, err := hyperscaler.Get(rt.Spec.Shoot.Provider.Type)
+		if err != nil {
+			return err
+		}
+
 		provider := &shoot.Spec.Provider
 		provider.Type = rt.Spec.Shoot.Provider.Type
 		provider.Workers = rt.Spec.Shoot.Provider.Workers
@@ -36,15 +42,21 @@ func NewProviderExtenderForCreateOperation(enableIMDSv2 bool, defMachineImgName,
 			return err
 		}
 
-		infraConfig, controlPlaneConf, err := getConfig(rt.Spec.Shoot, workerZones)
-		if err != nil {
-			return err
-		}
+		opts := newConfigOpts(rt, providerConfig, workerZones)
+		controlPlaneConf, infraConfig := rt.Spec.Shoot.Provider.ControlPlaneConfig, rt.Spec.Shoot.Provider.InfrastructureConfig
+
+		// the configs are generated only if they are not specified in the RuntimeCR
+		if controlPlaneConf == nil || infraConfig == nil {
+			generatedInfraConfig, generatedControlPlaneConf, err := getConfig(hyperscalerProvider, opts)
+			if err != nil {
+				return err
+			}
 
-		controlPlaneConf, infraConfig = overrideConfigIfProvided(rt, infraConfig, controlPlaneConf)
+			controlPlaneConf, infraConfig = overrideConfigIfProvided(rt, generatedInfraConfig, generatedControlPlaneConf)
+		}
 
 		// final validation
-		if err = checkWorkerZonesMatchProviderConfig(rt.Spec.Shoot.Provider.Type, workerZones, controlPlaneConf, infraConfig, false); err != nil {
+		if err = hyperscalerProvider.ValidateZones(workerZones, infraConfig, controlPlaneConf, false); err != nil {
 			return err
 		}
 
@@ -52,7 +64,7 @@ func NewProviderExtenderForCreateOperation(enableIMDSv2 bool, defMachineImgName,
 		provider.InfrastructureConfig = infraConfig
 
 		setMachineImage(provider, defMachineImgName, defMachineImgVer)
-		if err = setWorkerConfig(provider, provider.Type, enableIMDSv2); err != nil {
+		if err = setWorkerConfig(provider, hyperscalerProvider, opts); err != nil {
 			return err
 		}
 		setWorkerSettings(provider)
@@ -63,8 +75,13 @@ func NewProviderExtenderForCreateOperation(enableIMDSv2 bool, defMachineImgName,
 
 // Zones for patching workes are taken from existing shoot workers
 // InfrastructureConfig and ControlPlaneConfig are treated as immutable unless they are specified in the RuntimeCR
-func NewProviderExtenderPatchOperation(enableIMDSv2 bool, defMachineImgName, defMachineImgVer string, shootWorkers []gardener.Worker, existingInfraConfig *runtime.RawExtension, existingControlPlaneConfig *runtime.RawExtension) func(rt imv1.Runtime, shoot *gardener.Shoot) error {
+func NewProviderExtenderPatchOperation(providerConfig config.ProviderConfig, defMachineImgName, defMachineImgVer string, shootWorkers []gardener.Worker, existingInfraConfig *runtime.RawExtension, existingControlPlaneConfig *runtime.RawExtension) func(rt imv1.Runtime, shoot *gardener.Shoot) error {
 	return func(rt imv1.Runtime, shoot *gardener.Shoot) error {
+		hyperscalerProvider, err := hyperscaler.Get(rt.Spec.Shoot.Provider.Type)
+		if err != nil {
+			return err
+		}
+
 		provider := &shoot.Spec.Provider
 		provider.Type = rt.Spec.Shoot.Provider.Type
 		provider.Workers = rt.Spec.Shoot.Provider.Workers
@@ -84,8 +101,16 @@ func NewProviderExtenderPatchOperation(enableIMDSv2 bool, defMachineImgName, def
 			return err
 		}
 
+		// the zones added to the workers get their subnets in the existing infrastructureConfig, unless the config is provided in the Runtime CR
+		if rt.Spec.Shoot.Provider.InfrastructureConfig == nil {
+			infraConfig, err = hyperscalerProvider.AddZones(infraConfig, newConfigOpts(rt, providerConfig, workerZones))
+			if err != nil {
+				return err
+			}
+		}
+
 		// final validation
-		if err = checkWorkerZonesMatchProviderConfig(rt.Spec.Shoot.Provider.Type, workerZones, controlPlaneConf, infraConfig, true); err != nil {
+		if err = hyperscalerProvider.ValidateZones(workerZones, infraConfig, controlPlaneConf, true); err != nil {
 			return err
 		}
 
@@ -94,7 +119,7 @@ func NewProviderExtenderPatchOperation(enableIMDSv2 bool, defMachineImgName, def
 
 		setMachineImage(provider, defMachineImgName, defMachineImgVer)
 
-		if err := setWorkerConfig(provider, provider.Type, enableIMDSv2); err != nil {
+		if err := setWorkerConfig(provider, hyperscalerProvider, newConfigOpts(rt, providerConfig, workerZones)); err != nil {
 			return err
 		}
 
@@ -105,43 +130,6 @@ func NewProviderExtenderPatchOperation(enableIMDSv2 bool, defMachineImgName, def
 	}
 }
 
-func checkWorkerZonesMatchProviderConfig(providerType string, workerZones []string, ctrlPlaneConfig *runtime.RawExtension, infraConfig *runtime.RawExtension, patchValidation bool) error {
-	if providerType == hyperscaler.TypeAzure || providerType == hyperscaler.TypeAWS {
-		infraConfigZones, err := getZonesFromProviderConfig(providerType, infraConfig)
-		if err != nil {
-			return err
-		}
-
-		// workaround for legacy azure-lite shoots where networking zones are not specified in the infrastructureConfig
-		// such shoots are treated as correct, and we can skipp the validation of worker zones with infrastructureConfig zones
-		if patchValidation && providerType == hyperscaler.TypeAzure && len(infraConfigZones) == 0 {
-			return nil
-		}
-
-		for _, zone := range workerZones {
-			if !slices.Contains(infraConfigZones, zone) {
-				return fmt.Errorf("one of workers is using networking zone not specified in the %s infrastructureConfig: %s", providerType, zone)
-			}
-		}
-	}
-	if providerType == hyperscaler.TypeGCP {
-		ctrlPlaneZones, err := getZonesFromProviderConfig(providerType, ctrlPlaneConfig)
-		if err != nil {
-			return err
-		}
-
-		if len(ctrlPlaneZones) == 0 {
-			return fmt.Errorf("cannot validate workers zones against GCP controlPlaneConfig, cannot read current networking zone")
-		}
-
-		if !slices.Contains(workerZones, ctrlPlaneZones[0]) {
-			return fmt.Errorf("none of workers is using networking zone specified in the controlPlaneConfig: %s", ctrlPlaneZones[0])
-		}
-	}
-
-	return nil
-}
-
 func overrideConfigIfProvided(rt imv1.Runtime, existingInfraConfig, existingControlPlaneConfig *runtime.RawExtension) (*runtime.RawExtension, *runtime.RawExtension) {
 	controlPlaneConf := getConfigOrDefault(rt.Spec.Shoot.Provider.ControlPlaneConfig, existingControlPlaneConfig)
 	infraConfig := getConfigOrDefault(rt.Spec.Shoot.Provider.InfrastructureConfig, existingInfraConfig)
@@ -155,93 +143,29 @@ func getConfigOrDefault(config, defaultConfig *runtime.RawExtension) *runtime.Ra
 	return defaultConfig
 }
 
-// parse infrastructureConfig or controlPlaneConfig to get current set of networking zones
-func getZonesFromProviderConfig(providerType string, extensionConfig *runtime.RawExtension) ([]string, error) {
-	if extensionConfig == nil {
-		return nil, errors.New("infrastructureConfig is nil")
-	}
-
-	var zones []string
-
-	switch providerType {
-	case hyperscaler.TypeAWS:
-		infraConfig, err := aws.DecodeInfrastructureConfig(extensionConfig.Raw)
-		if err != nil {
-			return nil, err
-		}
-		for _, zone := range infraConfig.Networks.Zones {
-			zones = append(zones, zone.Name)
-		}
-	case hyperscaler.TypeAzure:
-		infraConfig, err := azure.DecodeInfrastructureConfig(extensionConfig.Raw)
-		if err != nil {
-			return nil, err
-		}
-		for _, zone := range infraConfig.Networks.Zones {
-			zones = append(zones, fmt.Sprint(zone.Name))
-		}
-	case hyperscaler.TypeGCP:
-		{
-			ctrlPlaneConfig, err := gcp.DecodeControlPlaneConfig(extensionConfig.Raw)
-			if err != nil {
-				return nil, err
-			}
-			zones = append(zones, ctrlPlaneConfig.Zone)
-		}
-	default:
-		return nil, errors.New("read zones from infrastructureConfig - provider not supported")
+func newConfigOpts(rt imv1.Runtime, providerConfig config.ProviderConfig, zones []string) hyperscaler.ConfigOpts {
+	return hyperscaler.ConfigOpts{
+		WorkersCidr: rt.Spec.Shoot.Networking.Nodes,
+		Zones:       zones,
+		Region:      rt.Spec.Shoot.Region,
+		IPFamilies:  rt.Spec.Shoot.Networking.IPFamilies,
+		Config:      providerConfig,
+		Provider:    rt.Spec.Shoot.Provider,
 	}
-	return zones, nil
 }
 
-type InfrastructureProviderFunc func(workersCidr string, zones []string) ([]byte, error)
-type ControlPlaneProviderFunc func(zones []string) ([]byte, error)
-
-func getConfig(runtimeShoot imv1.RuntimeShoot, zones []string) (infrastructureConfig *runtime.RawExtension, controlPlaneConfig *runtime.RawExtension, err error) {
-	getConfigForProvider := func(runtimeShoot imv1.RuntimeShoot, infrastructureConfigFunc InfrastructureProviderFunc, controlPlaneConfigFunc ControlPlaneProviderFunc) (*runtime.RawExtension, *runtime.RawExtension, error) {
-		infrastructureConfigBytes, err := infrastructureConfigFunc(runtimeShoot.Networking.Nodes, zones)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		controlPlaneConfigBytes, err := controlPlaneConfigFunc(zones)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		return &runtime.RawExtension{Raw: infrastructureConfigBytes}, &runtime.RawExtension{Raw: controlPlaneConfigBytes}, nil
-	}
-
-	switch runtimeShoot.Provider.Type {
-	case hyperscaler.TypeAWS:
-		{
-			return getConfigForProvider(runtimeShoot, aws.GetInfrastructureConfig, aws.GetControlPlaneConfig)
-		}
-	case hyperscaler.TypeAzure:
-		{
-			// Azure shoots are all zoned, put probably it not be validated here.
-			return getConfigForProvider(runtimeShoot, azure.GetInfrastructureConfig, azure.GetControlPlaneConfig)
-		}
-	case hyperscaler.TypeGCP:
-		{
-			return getConfigForProvider(runtimeShoot, gcp.GetInfrastructureConfig, gcp.GetControlPlaneConfig)
-		}
-	case hyperscaler.TypeOpenStack:
-		{
-			return getConfigForProvider(runtimeShoot, openstack.GetInfrastructureConfig, openstack.GetControlPlaneConfig)
-		}
-	default:
-		return nil, nil, errors.New("provider not supported")
+func getConfig(hyperscalerProvider hyperscaler.Provider, opts hyperscaler.ConfigOpts) (infrastructureConfig *runtime.RawExtension, controlPlaneConfig *runtime.RawExtension, err error) {
+	infrastructureConfigBytes, err := hyperscalerProvider.InfrastructureConfig(opts)
+	if err != nil {
+		return nil, nil, err
 	}
-}
 
-func getAWSWorkerConfig() (*runtime.RawExtension, error) {
-	workerConfigBytes, err := aws.GetWorkerConfig()
+	controlPlaneConfigBytes, err := hyperscalerProvider.ControlPlaneConfig(opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &runtime.RawExtension{Raw: workerConfigBytes}, nil
+	return &runtime.RawExtension{Raw: infrastructureConfigBytes}, &runtime.RawExtension{Raw: controlPlaneConfigBytes}, nil
 }
 
 func getNetworkingZonesFromWorkers(workers []gardener.Worker) ([]string, error) {
@@ -262,17 +186,30 @@ func getNetworkingZonesFromWorkers(workers []gardener.Worker) ([]string, error)
 	return zones, nil
 }
 
-func setWorkerConfig(provider *gardener.Provider, providerType string, enableIMDSv2 bool) error {
-	if providerType != hyperscaler.TypeAWS || !enableIMDSv2 {
-		return nil
+// The worker config template from the converter configuration, the worker config generated by the provider and the providerConfig of the Runtime worker are merged.
+// The fields of the Runtime worker providerConfig take precedence over the generated ones, and the generated ones over the template.
+func setWorkerConfig(provider *gardener.Provider, hyperscalerProvider hyperscaler.Provider, opts hyperscaler.ConfigOpts) error {
+	workerConfig, err := hyperscalerProvider.WorkerConfig(opts)
+	if err != nil {
+		return err
 	}
 
+	template := opts.Config.WorkerConfigTemplates[provider.Type]
+
 	for i := 0; i < len(provider.Workers); i++ {
-		var err error
-		provider.Workers[i].ProviderConfig, err = getAWSWorkerConfig()
+		worker := &provider.Workers[i]
 
+		var runtimeWorkerConfig []byte
+		if worker.ProviderConfig != nil {
+			runtimeWorkerConfig = worker.ProviderConfig.Raw
+		}
+
+		mergedWorkerConfig, err := hyperscaler.MergeWorkerConfigs(template, workerConfig, runtimeWorkerConfig)
 		if err != nil {
-			return err
+			return errors.Wrapf(err, "failed to merge providerConfig of worker %s", worker.Name)
+		}
+		if mergedWorkerConfig != nil {
+			worker.ProviderConfig = &runtime.RawExtension{Raw: mergedWorkerConfig}
 		}
 	}
 
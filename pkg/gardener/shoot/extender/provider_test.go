@@ -15,9 +15,11 @@ import (
 	ostext "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 )
 
 func TestProviderExtenderForCreateAWS(t *testing.T) {
@@ -37,6 +39,9 @@ func TestProviderExtenderForCreateAWS(t *testing.T) {
 				Spec: imv1.RuntimeSpec{
 					Shoot: imv1.RuntimeShoot{
 						Provider: fixProvider(hyperscaler.TypeAWS, "gardenlinux", "1312.2.0", []string{"eu-central-1a"}),
+						Networking: imv1.Networking{
+							Nodes: "10.250.0.0/22",
+						},
 					},
 				},
 			},
@@ -51,6 +56,9 @@ func TestProviderExtenderForCreateAWS(t *testing.T) {
 				Spec: imv1.RuntimeSpec{
 					Shoot: imv1.RuntimeShoot{
 						Provider: fixProvider(hyperscaler.TypeAWS, "gardenlinux", "1312.2.0", []string{"eu-central-1a", "eu-central-1b"}),
+						Networking: imv1.Networking{
+							Nodes: "10.250.0.0/22",
+						},
 					},
 				},
 			},
@@ -65,6 +73,9 @@ func TestProviderExtenderForCreateAWS(t *testing.T) {
 				Spec: imv1.RuntimeSpec{
 					Shoot: imv1.RuntimeShoot{
 						Provider: fixProvider(hyperscaler.TypeAWS, "gardenlinux", "1312.2.0", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}),
+						Networking: imv1.Networking{
+							Nodes: "10.250.0.0/22",
+						},
 					},
 				},
 			},
@@ -79,6 +90,9 @@ func TestProviderExtenderForCreateAWS(t *testing.T) {
 				Spec: imv1.RuntimeSpec{
 					Shoot: imv1.RuntimeShoot{
 						Provider: fixProvider(hyperscaler.TypeAWS, "", "", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}),
+						Networking: imv1.Networking{
+							Nodes: "10.250.0.0/22",
+						},
 					},
 				},
 			},
@@ -110,7 +124,7 @@ func TestProviderExtenderForCreateAWS(t *testing.T) {
 
 			// when
 
-			extender := NewProviderExtenderForCreateOperation(tc.EnableIMDSv2, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
+			extender := NewProviderExtenderForCreateOperation(fixProviderConfig(tc.EnableIMDSv2), tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -135,7 +149,7 @@ func TestProviderExtenderForCreateAWS(t *testing.T) {
 		}
 
 		// when
-		extender := NewProviderExtenderForCreateOperation(false, "", "")
+		extender := NewProviderExtenderForCreateOperation(config.ProviderConfig{}, "", "")
 		err := extender(rt, &shoot)
 
 		// then
@@ -317,7 +331,7 @@ func TestProviderExtenderForPatchSingleWorkerAWS(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderPatchOperation(tc.EnableIMDSv2, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
+			extender := NewProviderExtenderPatchOperation(fixProviderConfig(tc.EnableIMDSv2), tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -430,7 +444,7 @@ func TestProviderExtenderForCreateMultipleWorkersAWS(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderForCreateOperation(tc.EnableIMDSv2, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
+			extender := NewProviderExtenderForCreateOperation(fixProviderConfig(tc.EnableIMDSv2), tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -480,6 +494,29 @@ func TestProviderExtenderForPatchWorkersUpdateAWS(t *testing.T) {
 			ExistingInfraConfig:        fixAWSInfrastructureConfig("10.250.0.0/22", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}),
 			ExistingControlPlaneConfig: fixAWSControlPlaneConfig(),
 		},
+		"Extend main worker from non HA setup to HA setup by adding more zones, zones are added to infrastructureConfig": {
+			Runtime: imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Provider: fixProviderWithMultipleWorkers(hyperscaler.TypeAWS, fixMultipleWorkers([]workerConfig{
+							{"main-worker", "m6i.large", "gardenlinux", "1312.4.0", 1, 3, []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}},
+						})),
+						Networking: imv1.Networking{
+							Nodes: "10.250.0.0/22",
+						},
+					},
+				},
+			},
+			EnableIMDSv2:               false,
+			DefaultMachineImageName:    "gardenlinux",
+			DefaultMachineImageVersion: "1312.3.0",
+			ExpectedZonesCount:         3,
+			CurrentShootWorkers:        fixWorkers("main-worker", "m6i.large", "gardenlinux", "1312.4.0", 1, 3, []string{"eu-central-1a"}),
+			ExpectedShootWorkers: fixMultipleWorkers([]workerConfig{
+				{"main-worker", "m6i.large", "gardenlinux", "1312.4.0", 1, 3, []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}}}),
+			ExistingInfraConfig:        fixAWSInfrastructureConfig("10.250.0.0/22", []string{"eu-central-1a"}),
+			ExistingControlPlaneConfig: fixAWSControlPlaneConfig(),
+		},
 		"Add additional worker - extend existing additional worker from non HA setup to HA setup by adding more zones, infrastructureConfig already has three zones": {
 			Runtime: imv1.Runtime{
 				Spec: imv1.RuntimeSpec{
@@ -616,7 +653,7 @@ func TestProviderExtenderForPatchWorkersUpdateAWS(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderPatchOperation(tc.EnableIMDSv2, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
+			extender := NewProviderExtenderPatchOperation(fixProviderConfig(tc.EnableIMDSv2), tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -694,7 +731,7 @@ func TestProviderExtenderForCreateAzure(t *testing.T) {
 
 			// when
 
-			extender := NewProviderExtenderForCreateOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
+			extender := NewProviderExtenderForCreateOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -747,7 +784,7 @@ func TestProviderExtenderForCreateMultipleWorkersAzure(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderForCreateOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
+			extender := NewProviderExtenderForCreateOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -795,6 +832,28 @@ func TestProviderExtenderForPatchWorkersUpdateAzure(t *testing.T) {
 			ExistingInfraConfig:        fixAzureInfrastructureConfig("10.250.0.0/22", []string{"1", "2", "3"}),
 			ExistingControlPlaneConfig: fixAzureControlPlaneConfig(),
 		},
+		"Extend main worker from non HA setup to HA setup by adding more zones, zones are added to infrastructureConfig": {
+			Runtime: imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Provider: fixProviderWithMultipleWorkers(hyperscaler.TypeAzure, fixMultipleWorkers([]workerConfig{
+							{"main-worker", "azure.small", "gardenlinux", "1312.4.0", 1, 3, []string{"1", "2", "3"}},
+						})),
+						Networking: imv1.Networking{
+							Nodes: "10.250.0.0/22",
+						},
+					},
+				},
+			},
+			DefaultMachineImageName:    "gardenlinux",
+			DefaultMachineImageVersion: "1312.3.0",
+			ExpectedZonesCount:         3,
+			CurrentShootWorkers:        fixWorkers("main-worker", "azure.small", "gardenlinux", "1312.4.0", 1, 3, []string{"2"}),
+			ExpectedShootWorkers: fixMultipleWorkers([]workerConfig{
+				{"main-worker", "azure.small", "gardenlinux", "1312.4.0", 1, 3, []string{"2", "1", "3"}}}),
+			ExistingInfraConfig:        fixAzureInfrastructureConfig("10.250.0.0/22", []string{"2"}),
+			ExistingControlPlaneConfig: fixAzureControlPlaneConfig(),
+		},
 		"Add additional worker - extend existing additional worker from non HA setup to HA setup by adding more zones, infrastructureConfig already has three zones": {
 			Runtime: imv1.Runtime{
 				Spec: imv1.RuntimeSpec{
@@ -952,7 +1011,7 @@ func TestProviderExtenderForPatchWorkersUpdateAzure(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderPatchOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
+			extender := NewProviderExtenderPatchOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -1027,7 +1086,7 @@ func TestProviderExtenderForCreateGCP(t *testing.T) {
 
 			// when
 
-			extender := NewProviderExtenderForCreateOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
+			extender := NewProviderExtenderForCreateOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -1078,7 +1137,7 @@ func TestProviderExtenderForCreateMultipleWorkersGCP(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderForCreateOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
+			extender := NewProviderExtenderForCreateOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -1251,7 +1310,7 @@ func TestProviderExtenderForPatchWorkersUpdateGCP(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderPatchOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
+			extender := NewProviderExtenderPatchOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -1263,6 +1322,193 @@ func TestProviderExtenderForPatchWorkersUpdateGCP(t *testing.T) {
 	}
 }
 
+func TestProviderExtenderGCPSettings(t *testing.T) {
+	gcpSettings := &imv1.GCPProvider{
+		CloudNAT: &imv1.GCPCloudNAT{MinPortsPerVM: ptr.To(int32(2048)), EndpointIndependentMapping: true},
+		Internal: "10.251.0.0/24",
+		Worker: &imv1.GCPWorker{
+			ServiceAccount:    &imv1.GCPServiceAccount{Email: "workers@project.iam.gserviceaccount.com", Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"}},
+			LocalSSDInterface: "NVME",
+		},
+	}
+
+	fixRuntime := func(zones ...[]string) imv1.Runtime {
+		workers := []workerConfig{{"main-worker", "n2-standard-2", "gardenlinux", "1312.4.0", 1, 3, zones[0]}}
+		for _, additionalZones := range zones[1:] {
+			workers = append(workers, workerConfig{"additional", "n2-standard-2", "gardenlinux", "1312.4.0", 1, 3, additionalZones})
+		}
+
+		provider := fixProviderWithMultipleWorkers(hyperscaler.TypeGCP, fixMultipleWorkers(workers))
+		provider.GCP = gcpSettings
+		return imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Provider:   provider,
+					Networking: imv1.Networking{Nodes: "10.250.0.0/22"},
+				},
+			},
+		}
+	}
+
+	assertWorkerConfig := func(t *testing.T, shoot gardener.Shoot) {
+		require.NotEmpty(t, shoot.Spec.Provider.Workers)
+		for _, worker := range shoot.Spec.Provider.Workers {
+			require.NotNil(t, worker.ProviderConfig)
+
+			var workerConfig gcpext.WorkerConfig
+			require.NoError(t, json.Unmarshal(worker.ProviderConfig.Raw, &workerConfig))
+			assert.Equal(t, "WorkerConfig", workerConfig.Kind)
+			assert.Equal(t, &gcpext.ServiceAccount{Email: "workers@project.iam.gserviceaccount.com", Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"}}, workerConfig.ServiceAccount)
+			assert.Equal(t, &gcpext.Volume{LocalSSDInterface: ptr.To("NVME")}, workerConfig.Volume)
+		}
+	}
+
+	t.Run("Create regional GCP shoot with Cloud NAT, internal subnet and worker settings", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
+
+		// when
+		extender := NewProviderExtenderForCreateOperation(config.ProviderConfig{}, "gardenlinux", "1312.3.0")
+		err := extender(fixRuntime([]string{"us-central1-b", "us-central1-a"}, []string{"us-central1-c"}), &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		var ctrlPlaneConfig gcpext.ControlPlaneConfig
+		require.NoError(t, json.Unmarshal(shoot.Spec.Provider.ControlPlaneConfig.Raw, &ctrlPlaneConfig))
+		assert.Equal(t, "us-central1-b", ctrlPlaneConfig.Zone)
+
+		var infraConfig gcpext.InfrastructureConfig
+		require.NoError(t, json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infraConfig))
+		assert.Equal(t, &gcpext.CloudNAT{
+			MinPortsPerVM:              ptr.To(int32(2048)),
+			EndpointIndependentMapping: &gcpext.EndpointIndependentMapping{Enabled: true},
+		}, infraConfig.Networks.CloudNAT)
+		assert.Equal(t, ptr.To("10.251.0.0/24"), infraConfig.Networks.Internal)
+
+		assertWorkerConfig(t, shoot)
+	})
+
+	t.Run("Patch GCP shoot keeps the existing infrastructureConfig and sets the worker settings", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
+		existingInfraConfig := fixGCPInfrastructureConfig("10.250.0.0/22")
+
+		// when
+		extender := NewProviderExtenderPatchOperation(config.ProviderConfig{}, "gardenlinux", "1312.3.0",
+			fixWorkers("main-worker", "n2-standard-2", "gardenlinux", "1312.4.0", 1, 3, []string{"us-central1-a"}),
+			existingInfraConfig, fixGCPControlPlaneConfig([]string{"us-central1-a"}))
+		err := extender(fixRuntime([]string{"us-central1-a", "us-central1-b"}), &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, existingInfraConfig, shoot.Spec.Provider.InfrastructureConfig)
+		assertWorkerConfig(t, shoot)
+	})
+
+	t.Run("Return error for worker zone outside of the region of the controlPlaneConfig zone", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
+
+		// when
+		extender := NewProviderExtenderPatchOperation(config.ProviderConfig{}, "gardenlinux", "1312.3.0",
+			fixWorkers("main-worker", "n2-standard-2", "gardenlinux", "1312.4.0", 1, 3, []string{"us-central1-a"}),
+			fixGCPInfrastructureConfig("10.250.0.0/22"), fixGCPControlPlaneConfig([]string{"us-central1-a"}))
+		err := extender(fixRuntime([]string{"us-central1-a"}, []string{"us-east1-b"}), &shoot)
+
+		// then
+		require.EqualError(t, err, "one of workers is using networking zone us-east1-b outside of the region us-central1 of the controlPlaneConfig zone us-central1-a")
+	})
+}
+
+func TestProviderExtenderWorkerConfigTemplates(t *testing.T) {
+	providerConfig := config.ProviderConfig{
+		AWS: config.AWSConfig{EnableIMDSv2: true},
+		WorkerConfigTemplates: map[string]json.RawMessage{
+			hyperscaler.TypeAWS:       json.RawMessage(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"iops":3000,"throughput":125}}`),
+			hyperscaler.TypeOpenStack: json.RawMessage(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serverGroup":{"policy":"soft-anti-affinity"}}`),
+		},
+	}
+
+	t.Run("Merge the template, the generated worker config and the providerConfig of the Runtime worker on create", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
+		rt := imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Provider:   fixProvider(hyperscaler.TypeAWS, "gardenlinux", "1312.2.0", []string{"eu-central-1a"}),
+					Networking: imv1.Networking{Nodes: "10.250.0.0/22"},
+				},
+			},
+		}
+		rt.Spec.Shoot.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"volume":{"iops":6000}}`)}
+
+		// when
+		extender := NewProviderExtenderForCreateOperation(providerConfig, "gardenlinux", "1312.2.0")
+		err := extender(rt, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, shoot.Spec.Provider.Workers, 1)
+		assert.JSONEq(t, `{
+			"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1",
+			"kind":"WorkerConfig",
+			"volume":{"iops":6000,"throughput":125},
+			"instanceMetadataOptions":{"httpTokens":"required","httpPutResponseHopLimit":2}
+		}`, string(shoot.Spec.Provider.Workers[0].ProviderConfig.Raw))
+	})
+
+	t.Run("Set the template as the providerConfig of all workers on patch", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
+		rt := imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Provider: fixProviderWithMultipleWorkers(hyperscaler.TypeOpenStack, fixMultipleWorkers([]workerConfig{
+						{"main-worker", "g_c2_m8", "gardenlinux", "1312.4.0", 1, 3, []string{"eu-de-1a"}},
+						{"additional", "g_c2_m8", "gardenlinux", "1312.4.0", 1, 3, []string{"eu-de-1a"}},
+					})),
+					Networking: imv1.Networking{Nodes: "10.250.0.0/22"},
+				},
+			},
+		}
+
+		// when
+		extender := NewProviderExtenderPatchOperation(providerConfig, "gardenlinux", "1312.3.0",
+			fixWorkers("main-worker", "g_c2_m8", "gardenlinux", "1312.4.0", 1, 3, []string{"eu-de-1a"}),
+			fixOpenstackInfrastructureConfig("10.250.0.0/22"), fixOpenstackControlPlaneConfig())
+		err := extender(rt, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, shoot.Spec.Provider.Workers, 2)
+		for _, worker := range shoot.Spec.Provider.Workers {
+			assert.JSONEq(t, string(providerConfig.WorkerConfigTemplates[hyperscaler.TypeOpenStack]), string(worker.ProviderConfig.Raw))
+		}
+	})
+
+	t.Run("Return error for an invalid providerConfig of the Runtime worker", func(t *testing.T) {
+		// given
+		shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
+		rt := imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Provider:   fixProvider(hyperscaler.TypeAWS, "gardenlinux", "1312.2.0", []string{"eu-central-1a"}),
+					Networking: imv1.Networking{Nodes: "10.250.0.0/22"},
+				},
+			},
+		}
+		rt.Spec.Shoot.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`"WorkerConfig"`)}
+
+		// when
+		extender := NewProviderExtenderForCreateOperation(providerConfig, "gardenlinux", "1312.2.0")
+		err := extender(rt, &shoot)
+
+		// then
+		require.ErrorContains(t, err, "failed to merge providerConfig of worker worker")
+	})
+}
+
 func TestProviderExtenderForCreateOpenstack(t *testing.T) {
 	// tests of NewProviderExtenderForCreateOperation for workers create operation
 	for tname, tc := range map[string]struct {
@@ -1347,7 +1593,7 @@ func TestProviderExtenderForCreateOpenstack(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderForCreateOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
+			extender := NewProviderExtenderForCreateOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -1527,7 +1773,7 @@ func TestProviderExtenderForPatchWorkersUpdateOpenstack(t *testing.T) {
 			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
 
 			// when
-			extender := NewProviderExtenderPatchOperation(false, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
+			extender := NewProviderExtenderPatchOperation(config.ProviderConfig{}, tc.DefaultMachineImageName, tc.DefaultMachineImageVersion, tc.CurrentShootWorkers, tc.ExistingInfraConfig, tc.ExistingControlPlaneConfig)
 			err := extender(tc.Runtime, &shoot)
 
 			// then
@@ -1539,6 +1785,82 @@ func TestProviderExtenderForPatchWorkersUpdateOpenstack(t *testing.T) {
 	}
 }
 
+func TestProviderExtenderForCreateOpenstackSettings(t *testing.T) {
+	providerConfig := config.ProviderConfig{
+		OpenStack: config.OpenStackConfig{
+			OpenStackSettings: config.OpenStackSettings{
+				FloatingPoolName:     "FloatingIP-external-default",
+				LoadBalancerProvider: "octavia",
+			},
+			Regions: map[string]config.OpenStackSettings{
+				"eu-de-2": {FloatingPoolName: "FloatingIP-external-eu-de-2"},
+			},
+		},
+	}
+
+	for tname, tc := range map[string]struct {
+		Region                       string
+		OpenStack                    *imv1.OpenStackProvider
+		ExpectedFloatingPoolName     string
+		ExpectedLoadBalancerProvider string
+	}{
+		"Use configured defaults": {
+			Region:                       "eu-de-1",
+			ExpectedFloatingPoolName:     "FloatingIP-external-default",
+			ExpectedLoadBalancerProvider: "octavia",
+		},
+		"Use region settings": {
+			Region:                       "eu-de-2",
+			ExpectedFloatingPoolName:     "FloatingIP-external-eu-de-2",
+			ExpectedLoadBalancerProvider: "octavia",
+		},
+		"Use Runtime CR settings": {
+			Region:                       "eu-de-2",
+			OpenStack:                    &imv1.OpenStackProvider{FloatingPoolName: "FloatingIP-external-runtime", LoadBalancerProvider: "f5"},
+			ExpectedFloatingPoolName:     "FloatingIP-external-runtime",
+			ExpectedLoadBalancerProvider: "f5",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := fixEmptyGardenerShoot("cluster", "kcp-system")
+			provider := fixProvider(hyperscaler.TypeOpenStack, "gardenlinux", "1312.2.0", []string{"eu-de-1a"})
+			provider.OpenStack = tc.OpenStack
+			rt := imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Region:   tc.Region,
+						Provider: provider,
+						Networking: imv1.Networking{
+							Nodes: "10.250.0.0/22",
+						},
+					},
+				},
+			}
+
+			// when
+			err := NewProviderExtenderForCreateOperation(providerConfig, "", "")(rt, &shoot)
+
+			// then
+			require.NoError(t, err)
+
+			var infraConfig ostext.InfrastructureConfig
+			require.NoError(t, json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infraConfig))
+			assert.Equal(t, tc.ExpectedFloatingPoolName, infraConfig.FloatingPoolName)
+
+			var ctrlPlaneConfig ostext.ControlPlaneConfig
+			require.NoError(t, json.Unmarshal(shoot.Spec.Provider.ControlPlaneConfig.Raw, &ctrlPlaneConfig))
+			assert.Equal(t, tc.ExpectedLoadBalancerProvider, ctrlPlaneConfig.LoadBalancerProvider)
+		})
+	}
+}
+
+func fixProviderConfig(enableIMDSv2 bool) config.ProviderConfig {
+	return config.ProviderConfig{
+		AWS: config.AWSConfig{EnableIMDSv2: enableIMDSv2},
+	}
+}
+
 func fixProvider(providerType string, machineImageName, machineImageVersion string, zones []string) imv1.Provider {
 	return imv1.Provider{
 		Type: providerType,
@@ -1,15 +1,30 @@
 package extender
 
 import (
+	"slices"
+
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 )
 
-func ExtendWithTolerations(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-	if runtime.Spec.Shoot.Region == "me-central2" {
-		shoot.Spec.Tolerations = append(shoot.Spec.Tolerations, gardener.Toleration{
-			Key: "ksa-assured-workload",
-		})
+func NewTolerationsExtender(shootRules []config.ShootRule) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		for _, rule := range shootRules {
+			if !rule.Matches(runtime.Spec.Shoot.PlatformRegion, runtime.Spec.Shoot.Region) {
+				continue
+			}
+
+			for _, toleration := range rule.Tolerations {
+				if slices.ContainsFunc(shoot.Spec.Tolerations, func(existing gardener.Toleration) bool { return existing.Key == toleration.Key }) {
+					continue
+				}
+				shoot.Spec.Tolerations = append(shoot.Spec.Tolerations, gardener.Toleration{
+					Key:   toleration.Key,
+					Value: toleration.Value,
+				})
+			}
+		}
+		return nil
 	}
-	return nil
 }
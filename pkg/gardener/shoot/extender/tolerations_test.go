@@ -5,9 +5,11 @@ import (
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestTolerationsExtender(t *testing.T) {
@@ -47,7 +49,7 @@ func TestTolerationsExtender(t *testing.T) {
 			}
 
 			// when
-			err := ExtendWithTolerations(basicRuntime, &shoot)
+			err := NewTolerationsExtender(config.DefaultShootRules())(basicRuntime, &shoot)
 			require.NoError(t, err)
 
 			// then
@@ -55,3 +57,36 @@ func TestTolerationsExtender(t *testing.T) {
 		})
 	}
 }
+
+func TestTolerationsExtenderWithShootRules(t *testing.T) {
+	// given
+	shootRules := []config.ShootRule{
+		{
+			PlatformRegions: []string{"cf-sa30"},
+			Tolerations:     []config.Toleration{{Key: "ksa-assured-workload"}},
+		},
+		{
+			Regions:     []string{"me-central2"},
+			Tolerations: []config.Toleration{{Key: "ksa-assured-workload"}, {Key: "sovereign", Value: ptr.To("true")}},
+		},
+	}
+	runtime := imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				PlatformRegion: "cf-sa30",
+				Region:         "me-central2",
+			},
+		},
+	}
+	shoot := fixEmptyGardenerShoot("shoot", "kcp-system")
+
+	// when
+	err := NewTolerationsExtender(shootRules)(runtime, &shoot)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []gardener.Toleration{
+		{Key: "ksa-assured-workload"},
+		{Key: "sovereign", Value: ptr.To("true")},
+	}, shoot.Spec.Tolerations)
+}
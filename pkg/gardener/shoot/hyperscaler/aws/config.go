@@ -16,7 +16,11 @@ const workerConfigKind = "WorkerConfig"
 const awsIMDSv2HTTPPutResponseHopLimit int64 = 2
 
 func GetInfrastructureConfig(workersCidr string, zones []string) ([]byte, error) {
-	return json.Marshal(NewInfrastructureConfig(workersCidr, zones))
+	infrastructureConfig, err := NewInfrastructureConfig(workersCidr, zones)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(infrastructureConfig)
 }
 
 func GetControlPlaneConfig(_ []string) ([]byte, error) {
@@ -36,19 +40,24 @@ func DecodeInfrastructureConfig(data []byte) (*v1alpha1.InfrastructureConfig, er
 	return infrastructureConfig, nil
 }
 
-func NewInfrastructureConfig(workersCidr string, zones []string) v1alpha1.InfrastructureConfig {
+func NewInfrastructureConfig(workersCidr string, zones []string) (v1alpha1.InfrastructureConfig, error) {
+	awsZones, err := generateAWSZones(workersCidr, zones)
+	if err != nil {
+		return v1alpha1.InfrastructureConfig{}, err
+	}
+
 	return v1alpha1.InfrastructureConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       infrastructureConfigKind,
 			APIVersion: apiVersion,
 		},
 		Networks: v1alpha1.Networks{
-			Zones: generateAWSZones(workersCidr, zones),
+			Zones: awsZones,
 			VPC: v1alpha1.VPC{
 				CIDR: &workersCidr,
 			},
 		},
-	}
+	}, nil
 }
 
 func NewControlPlaneConfig() *v1alpha1.ControlPlaneConfig {
@@ -88,6 +88,34 @@ func TestInfrastructureConfig(t *testing.T) {
 				},
 			},
 		},
+		"IPv6 2001:db8::/56": {
+			givenNodesCidr: "2001:db8::/56",
+			givenZoneNames: []string{
+				"eu-central-1a",
+				"eu-central-1b",
+				"eu-central-1c",
+			},
+			expectedAwsZones: []v1alpha1.Zone{
+				{
+					Name:     "eu-central-1a",
+					Workers:  "2001:db8::/59",
+					Public:   "2001:db8:0:20::/60",
+					Internal: "2001:db8:0:30::/60",
+				},
+				{
+					Name:     "eu-central-1b",
+					Workers:  "2001:db8:0:40::/59",
+					Public:   "2001:db8:0:60::/60",
+					Internal: "2001:db8:0:70::/60",
+				},
+				{
+					Name:     "eu-central-1c",
+					Workers:  "2001:db8:0:80::/59",
+					Public:   "2001:db8:0:a0::/60",
+					Internal: "2001:db8:0:b0::/60",
+				},
+			},
+		},
 	} {
 		t.Run(tname, func(t *testing.T) {
 			// when
@@ -106,6 +134,7 @@ func TestInfrastructureConfig(t *testing.T) {
 			assert.Equal(t, infrastructureConfigKind, infrastructureConfig.TypeMeta.Kind)
 
 			assert.Equal(t, tcase.givenNodesCidr, *infrastructureConfig.Networks.VPC.CIDR)
+			require.Len(t, infrastructureConfig.Networks.Zones, len(tcase.expectedAwsZones))
 			for i, actualZone := range infrastructureConfig.Networks.Zones {
 				assertIPRanges(t, tcase.expectedAwsZones[i], actualZone)
 			}
@@ -113,6 +142,38 @@ func TestInfrastructureConfig(t *testing.T) {
 	}
 }
 
+func TestInfrastructureConfigErrors(t *testing.T) {
+	for tname, tcase := range map[string]struct {
+		givenNodesCidr string
+		givenZoneNames []string
+		expectedError  string
+	}{
+		"Malformed CIDR": {
+			givenNodesCidr: "10.250.0.0",
+			givenZoneNames: []string{"eu-central-1a"},
+			expectedError:  `invalid nodes CIDR "10.250.0.0": netip.ParsePrefix("10.250.0.0"): no '/'`,
+		},
+		"Too many zones": {
+			givenNodesCidr: "10.250.0.0/16",
+			givenZoneNames: []string{"eu-central-1a", "eu-central-1b", "eu-central-1c", "eu-central-1d", "eu-central-1e"},
+			expectedError:  "nodes CIDR 10.250.0.0/16 cannot be split into 5 zones, at most 4 zones are supported",
+		},
+		"CIDR too small": {
+			givenNodesCidr: "10.250.0.0/26",
+			givenZoneNames: []string{"eu-central-1a"},
+			expectedError:  "nodes CIDR 10.250.0.0/26 is too small for the zone subnets: the smallest subnet would be /30, at most /28 is allowed, use a /24 or larger CIDR",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// when
+			_, err := GetInfrastructureConfig(tcase.givenNodesCidr, tcase.givenZoneNames)
+
+			// then
+			require.EqualError(t, err, tcase.expectedError)
+		})
+	}
+}
+
 func assertIPRanges(t *testing.T, expectedZone v1alpha1.Zone, actualZone v1alpha1.Zone) {
 	assert.Equal(t, expectedZone.Name, actualZone.Name)
 	assert.Equal(t, expectedZone.Internal, actualZone.Internal)
@@ -136,3 +197,66 @@ func TestWorkerConfig(t *testing.T) {
 		assert.Equal(t, v1alpha1.HTTPTokensRequired, *config.InstanceMetadataOptions.HTTPTokens)
 	})
 }
+
+func TestAddZones(t *testing.T) {
+	t.Run("Should add the subnets of new zones and keep the existing zones", func(t *testing.T) {
+		// given
+		existingConfig, err := GetInfrastructureConfig("10.250.0.0/22", []string{"eu-central-1a", "eu-central-1b"})
+		require.NoError(t, err)
+		expectedConfig, err := NewInfrastructureConfig("10.250.0.0/22", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"})
+		require.NoError(t, err)
+
+		// when
+		result, err := addZones(existingConfig, "10.250.0.0/22", []string{"eu-central-1b", "eu-central-1a", "eu-central-1c"})
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := DecodeInfrastructureConfig(result)
+		require.NoError(t, err)
+		assert.Equal(t, expectedConfig.Networks.Zones, infrastructureConfig.Networks.Zones)
+	})
+
+	t.Run("Should use the first subnets not used by the existing zones", func(t *testing.T) {
+		// given
+		existingConfig := []byte(`{"networks":{"vpc":{"cidr":"10.250.0.0/22"},"zones":[{"name":"eu-central-1b","workers":"10.250.1.0/25","public":"10.250.1.128/26","internal":"10.250.1.192/26"}]}}`)
+
+		// when
+		result, err := addZones(existingConfig, "10.180.0.0/16", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"})
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := DecodeInfrastructureConfig(result)
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.Zone{
+			{Name: "eu-central-1b", Workers: "10.250.1.0/25", Public: "10.250.1.128/26", Internal: "10.250.1.192/26"},
+			{Name: "eu-central-1a", Workers: "10.250.0.0/25", Public: "10.250.0.128/26", Internal: "10.250.0.192/26"},
+			{Name: "eu-central-1c", Workers: "10.250.2.0/25", Public: "10.250.2.128/26", Internal: "10.250.2.192/26"},
+		}, infrastructureConfig.Networks.Zones)
+	})
+
+	t.Run("Should keep the config unchanged if no zone is added", func(t *testing.T) {
+		// given
+		existingConfig := []byte(`{"networks":{"vpc":{"cidr":"10.250.0.0/22"},"zones":[{"name":"eu-central-1a","workers":"10.250.0.0/25","public":"10.250.0.128/26","internal":"10.250.0.192/26"}]},"ignoreTags":{"keys":["key"]}}`)
+
+		// when
+		result, err := addZones(existingConfig, "10.250.0.0/22", []string{"eu-central-1a"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, existingConfig, result)
+	})
+
+	t.Run("Should return error if the CIDR cannot fit the new zone", func(t *testing.T) {
+		// given
+		existingConfig, err := GetInfrastructureConfig("10.250.0.0/22", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c", "eu-central-1d"})
+		require.NoError(t, err)
+
+		// when
+		_, err = addZones(existingConfig, "10.250.0.0/22", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c", "eu-central-1d", "eu-central-1e"})
+
+		// then
+		require.EqualError(t, err, "nodes CIDR 10.250.0.0/22 cannot be split into 5 zones, at most 4 zones are supported")
+	})
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+const DefaultCloudProfileName = "aws"
+
+var vpcIDPattern = regexp.MustCompile(`^vpc-([0-9a-f]{8}|[0-9a-f]{17})$`)
+
+func init() {
+	hyperscaler.Register(hyperscaler.TypeAWS, Provider{})
+}
+
+type Provider struct{}
+
+// The IPv6 ranges of dual-stack shoots are assigned by AWS, only the zone subnets of the primary family are generated.
+// The zone subnets are created in the existing VPC if it is referenced in the Runtime.
+func (Provider) InfrastructureConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	infrastructureConfig, err := NewInfrastructureConfig(opts.WorkersCidr, opts.Zones)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Provider.AWS != nil && opts.Provider.AWS.VPC != nil {
+		// the VPC ID and CIDR are mutually exclusive
+		infrastructureConfig.Networks.VPC = v1alpha1.VPC{ID: ptr.To(opts.Provider.AWS.VPC.ID)}
+	}
+	if hyperscaler.IsDualStack(opts.IPFamilies) {
+		infrastructureConfig.DualStack = &v1alpha1.DualStack{Enabled: true}
+	}
+	return json.Marshal(infrastructureConfig)
+}
+
+func (Provider) ControlPlaneConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	return GetControlPlaneConfig(opts.Zones)
+}
+
+func (Provider) WorkerConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	if !opts.Config.AWS.EnableIMDSv2 {
+		return nil, nil
+	}
+	return GetWorkerConfig()
+}
+
+func (Provider) Zones(infrastructureConfig, _ *runtime.RawExtension) ([]string, error) {
+	if infrastructureConfig == nil {
+		return nil, errors.New("infrastructureConfig is nil")
+	}
+
+	infraConfig, err := DecodeInfrastructureConfig(infrastructureConfig.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []string
+	for _, zone := range infraConfig.Networks.Zones {
+		zones = append(zones, zone.Name)
+	}
+	return zones, nil
+}
+
+// The subnets of the new zones are carved from the parts of the VPC CIDR not used by the existing zones
+func (Provider) AddZones(infrastructureConfig *runtime.RawExtension, opts hyperscaler.ConfigOpts) (*runtime.RawExtension, error) {
+	if infrastructureConfig == nil {
+		return nil, nil
+	}
+
+	infraConfig, err := addZones(infrastructureConfig.Raw, opts.WorkersCidr, opts.Zones)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: infraConfig}, nil
+}
+
+func (p Provider) ValidateZones(workerZones []string, infrastructureConfig, controlPlaneConfig *runtime.RawExtension, _ bool) error {
+	infraConfigZones, err := p.Zones(infrastructureConfig, controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range workerZones {
+		if !slices.Contains(infraConfigZones, zone) {
+			return fmt.Errorf("one of workers is using networking zone not specified in the %s infrastructureConfig: %s", hyperscaler.TypeAWS, zone)
+		}
+	}
+	return nil
+}
+
+// ValidateRuntime checks the reference to the existing VPC, it is used only in the infrastructureConfig of the new shoot
+func (Provider) ValidateRuntime(shoot imv1.RuntimeShoot, fldPath *field.Path, patch bool) field.ErrorList {
+	if patch || shoot.Provider.AWS == nil || shoot.Provider.AWS.VPC == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	awsPath := fldPath.Child("provider", "aws")
+	vpc := shoot.Provider.AWS.VPC
+
+	if shoot.Provider.Type != hyperscaler.TypeAWS {
+		allErrs = append(allErrs, field.Forbidden(awsPath, fmt.Sprintf("can be set only for %s runtimes", hyperscaler.TypeAWS)))
+	}
+	if !vpcIDPattern.MatchString(vpc.ID) {
+		allErrs = append(allErrs, field.Invalid(awsPath.Child("vpc", "id"), vpc.ID, "must be a VPC ID, for example vpc-0123456789abcdef0"))
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	return hyperscaler.ValidateExistingNetwork(shoot, fldPath, awsPath.Child("vpc"), vpc.CIDR, maxZones)
+}
+
+func (Provider) MaxZones() int {
+	return maxZones
+}
+
+func (Provider) CloudProfileName() string {
+	return DefaultCloudProfileName
+}
+
+func (Provider) ExposureClassName(_ hyperscaler.ConfigOpts) *string {
+	return nil
+}
@@ -1,19 +1,22 @@
 package aws
 
 import (
+	"fmt"
 	"math/big"
 	"net/netip"
+	"slices"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
 )
 
-const workersBits = 3
-const lastBitNumber = 31
+// maxZones is the number of zones fitting in the nodes CIDR, every zone uses the workers subnet and the public and internal subnets of half its size
+const maxZones = 1 << (hyperscaler.ZoneSubnetBits - 1)
 
 /*
 *
 generateAWSZones - creates a list of AWSZoneInput objects which contains a proper IP ranges.
-It generates subnets - the subnets in AZ must be inside of the cidr block and non overlapping. example values:
+It generates subnets - the subnets in AZ must be inside of the cidr block and non overlapping, IPv4 and IPv6 CIDRs are supported. example values:
 cidr: 10.250.0.0/16
   - name: eu-central-1a
     workers: 10.250.0.0/19
@@ -28,12 +31,18 @@ cidr: 10.250.0.0/16
     public: 10.250.160.0/20
     internal: 10.250.176.0/20
 */
-func generateAWSZones(workerCidr string, zoneNames []string) []v1alpha1.Zone {
+func generateAWSZones(workerCidr string, zoneNames []string) ([]v1alpha1.Zone, error) {
 	var zones []v1alpha1.Zone
 
-	cidr, _ := netip.ParsePrefix(workerCidr)
-	workerPrefixLength := cidr.Bits() + workersBits
+	cidr, err := hyperscaler.ParseZonesCIDR(workerCidr, len(zoneNames), maxZones)
+	if err != nil {
+		return nil, err
+	}
+
+	workerPrefixLength := cidr.Bits() + hyperscaler.ZoneSubnetBits
 	workerPrefix, _ := cidr.Addr().Prefix(workerPrefixLength)
+	bitLen := cidr.Addr().BitLen()
+	lastBitNumber := bitLen - 1
 
 	// delta - it is the difference between "public" and "internal" CIDRs, for example:
 	//    WorkerCidr:   "10.250.0.0/19",
@@ -47,16 +56,16 @@ func generateAWSZones(workerCidr string, zoneNames []string) []v1alpha1.Zone {
 	base := new(big.Int).SetBytes(workerPrefix.Addr().AsSlice())
 
 	for _, name := range zoneNames {
-		zoneWorkerIP, _ := netip.AddrFromSlice(base.Bytes())
+		zoneWorkerIP := hyperscaler.AddrFromInt(base, bitLen)
 		zoneWorkerCidr := netip.PrefixFrom(zoneWorkerIP, workerPrefixLength)
 
 		base.Add(base, delta)
 		base.Add(base, delta)
-		publicIP, _ := netip.AddrFromSlice(base.Bytes())
+		publicIP := hyperscaler.AddrFromInt(base, bitLen)
 		public := netip.PrefixFrom(publicIP, workerPrefixLength+1)
 
 		base.Add(base, delta)
-		internalIP, _ := netip.AddrFromSlice(base.Bytes())
+		internalIP := hyperscaler.AddrFromInt(base, bitLen)
 		internalPrefix := netip.PrefixFrom(internalIP, workerPrefixLength+1)
 
 		zones = append(zones, v1alpha1.Zone{
@@ -69,5 +78,90 @@ func generateAWSZones(workerCidr string, zoneNames []string) []v1alpha1.Zone {
 		base.Add(base, delta)
 	}
 
-	return zones
+	return zones, nil
+}
+
+// addZones appends the subnets of the zones missing in the infrastructure config, the subnets of the existing zones are not changed.
+// The subnets are carved from the VPC CIDR, or from the nodes CIDR if the VPC CIDR is not set, the new zones get the first zone subnets
+// which are not used by the existing zones.
+func addZones(infrastructureConfig []byte, nodesCidr string, zoneNames []string) ([]byte, error) {
+	infraConfig, err := DecodeInfrastructureConfig(infrastructureConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var existingZones []string
+	var usedSubnets []netip.Prefix
+	for _, zone := range infraConfig.Networks.Zones {
+		existingZones = append(existingZones, zone.Name)
+
+		subnets, err := parseZoneSubnets(zone)
+		if err != nil {
+			return nil, err
+		}
+		usedSubnets = append(usedSubnets, subnets...)
+	}
+
+	var newZones []string
+	for _, name := range zoneNames {
+		if !slices.Contains(existingZones, name) && !slices.Contains(newZones, name) {
+			newZones = append(newZones, name)
+		}
+	}
+
+	if len(newZones) == 0 {
+		return infrastructureConfig, nil
+	}
+
+	if infraConfig.Networks.VPC.CIDR != nil {
+		nodesCidr = *infraConfig.Networks.VPC.CIDR
+	}
+
+	cidr, err := hyperscaler.ParseZonesCIDR(nodesCidr, len(existingZones)+len(newZones), maxZones)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := generateAWSZones(nodesCidr, make([]string, maxZones))
+	if err != nil {
+		return nil, err
+	}
+
+	var addedZones []v1alpha1.Zone
+	for _, candidate := range candidates {
+		if len(addedZones) == len(newZones) {
+			break
+		}
+
+		subnets, err := parseZoneSubnets(candidate)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hyperscaler.SubnetsFree(cidr, subnets, usedSubnets) {
+			continue
+		}
+
+		candidate.Name = newZones[len(addedZones)]
+		addedZones = append(addedZones, candidate)
+		usedSubnets = append(usedSubnets, subnets...)
+	}
+
+	if len(addedZones) < len(newZones) {
+		return nil, fmt.Errorf("no free subnets left in the CIDR %s for the zone %s", cidr, newZones[len(addedZones)])
+	}
+
+	return hyperscaler.AppendZones(infrastructureConfig, addedZones)
+}
+
+func parseZoneSubnets(zone v1alpha1.Zone) ([]netip.Prefix, error) {
+	var subnets []netip.Prefix
+	for _, subnet := range []string{zone.Workers, zone.Public, zone.Internal} {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet of the zone %s in the infrastructureConfig: %w", zone.Name, err)
+		}
+		subnets = append(subnets, prefix)
+	}
+	return subnets, nil
 }
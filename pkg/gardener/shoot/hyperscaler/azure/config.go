@@ -11,7 +11,11 @@ const controlPlaneConfigKind = "ControlPlaneConfig"
 const apiVersion = "azure.provider.extensions.gardener.cloud/v1alpha1"
 
 func GetInfrastructureConfig(workerCIDR string, zones []string) ([]byte, error) {
-	return json.Marshal(NewInfrastructureConfig(workerCIDR, zones))
+	infrastructureConfig, err := NewInfrastructureConfig(workerCIDR, zones)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(infrastructureConfig)
 }
 
 func GetControlPlaneConfig(_ []string) ([]byte, error) {
@@ -36,7 +40,7 @@ func DecodeInfrastructureConfig(data []byte) (*InfrastructureConfig, error) {
 	return infrastructureConfig, nil
 }
 
-func NewInfrastructureConfig(workerCIDR string, zones []string) InfrastructureConfig {
+func NewInfrastructureConfig(workerCIDR string, zones []string) (InfrastructureConfig, error) {
 	// All Azure shoots are zoned.
 	// No zones - the shoot configuration is invalid.
 	// We should validate the config before calling this function.
@@ -55,7 +59,11 @@ func NewInfrastructureConfig(workerCIDR string, zones []string) InfrastructureCo
 		Zoned: isZoned,
 	}
 
-	azureConfig.Networks.Zones = generateAzureZones(workerCIDR, zones)
+	azureZones, err := generateAzureZones(workerCIDR, zones)
+	if err != nil {
+		return InfrastructureConfig{}, err
+	}
+	azureConfig.Networks.Zones = azureZones
 
-	return azureConfig
+	return azureConfig, nil
 }
@@ -114,6 +114,41 @@ func TestInfrastructureConfig(t *testing.T) {
 				},
 			},
 		},
+		"Zoned setup for 3 zones and IPv6 CIDR 2001:db8:0:10::/60": {
+			expectedIsZoned: true,
+			givenVnetCidr:   "2001:db8:0:10::/60",
+			givenZoneNames: []string{
+				"1",
+				"2",
+				"3",
+			},
+			expectedAzureZones: []Zone{
+				{
+					Name: 1,
+					CIDR: "2001:db8:0:10::/63",
+					NatGateway: &NatGateway{
+						Enabled:                      true,
+						IdleConnectionTimeoutMinutes: defaultConnectionTimeOutMinutes,
+					},
+				},
+				{
+					Name: 2,
+					CIDR: "2001:db8:0:12::/63",
+					NatGateway: &NatGateway{
+						Enabled:                      true,
+						IdleConnectionTimeoutMinutes: defaultConnectionTimeOutMinutes,
+					},
+				},
+				{
+					Name: 3,
+					CIDR: "2001:db8:0:14::/63",
+					NatGateway: &NatGateway{
+						Enabled:                      true,
+						IdleConnectionTimeoutMinutes: defaultConnectionTimeOutMinutes,
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tname, func(t *testing.T) {
 			// when
@@ -136,6 +171,7 @@ func TestInfrastructureConfig(t *testing.T) {
 			assert.Equal(t, tcase.givenVnetCidr, *infrastructureConfig.Networks.VNet.CIDR)
 			assert.Equal(t, true, infrastructureConfig.Zoned)
 
+			require.Len(t, infrastructureConfig.Networks.Zones, len(tcase.expectedAzureZones))
 			for i, actualZone := range infrastructureConfig.Networks.Zones {
 				assertAzureZoneCidrs(t, tcase.expectedAzureZones[i], actualZone)
 			}
@@ -143,9 +179,91 @@ func TestInfrastructureConfig(t *testing.T) {
 	}
 }
 
+func TestInfrastructureConfigErrors(t *testing.T) {
+	t.Run("Should not generate zones for the CIDR which is not a network address", func(t *testing.T) {
+		// when
+		_, err := GetInfrastructureConfig("10.250.1.0/22", []string{"1", "2"})
+
+		// then
+		require.EqualError(t, err, "nodes CIDR 10.250.1.0/22 is not a network address, use 10.250.0.0/22")
+	})
+
+	t.Run("Should not generate zones for an invalid zone name", func(t *testing.T) {
+		// when
+		_, err := GetInfrastructureConfig(DefaultNodesCIDR, []string{"1", "westeurope-2"})
+
+		// then
+		require.EqualError(t, err, `invalid azure zone "westeurope-2", supported zones are 1, 2 and 3`)
+	})
+}
+
 func assertAzureZoneCidrs(t *testing.T, expectedZone Zone, actualZone Zone) {
 	assert.Equal(t, expectedZone.Name, actualZone.Name)
 	assert.Equal(t, expectedZone.CIDR, actualZone.CIDR)
 	assert.Equal(t, expectedZone.NatGateway.Enabled, actualZone.NatGateway.Enabled)
 	assert.Equal(t, expectedZone.NatGateway.IdleConnectionTimeoutMinutes, actualZone.NatGateway.IdleConnectionTimeoutMinutes)
 }
+
+func TestAddZones(t *testing.T) {
+	t.Run("Should add the subnets of new zones and keep the existing zones", func(t *testing.T) {
+		// given
+		existingConfig, err := GetInfrastructureConfig(DefaultNodesCIDR, []string{"1", "2"})
+		require.NoError(t, err)
+		expectedConfig, err := NewInfrastructureConfig(DefaultNodesCIDR, []string{"1", "2", "3"})
+		require.NoError(t, err)
+
+		// when
+		result, err := addZones(existingConfig, DefaultNodesCIDR, []string{"2", "1", "3"})
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := DecodeInfrastructureConfig(result)
+		require.NoError(t, err)
+		assert.Equal(t, expectedConfig.Networks.Zones, infrastructureConfig.Networks.Zones)
+	})
+
+	t.Run("Should use the first free subnet and the NAT gateway setup of the existing zones", func(t *testing.T) {
+		// given
+		existingConfig := []byte(`{"networks":{"vnet":{"cidr":"10.250.0.0/22"},"zones":[{"name":2,"cidr":"10.250.0.0/25","natGateway":{"enabled":false,"idleConnectionTimeoutMinutes":4}},{"name":3,"cidr":"10.250.1.0/25"}]},"zoned":true}`)
+
+		// when
+		result, err := addZones(existingConfig, "10.180.0.0/16", []string{"1", "2", "3"})
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := DecodeInfrastructureConfig(result)
+		require.NoError(t, err)
+		require.Len(t, infrastructureConfig.Networks.Zones, 3)
+		assert.Equal(t, Zone{
+			Name:       1,
+			CIDR:       "10.250.0.128/25",
+			NatGateway: &NatGateway{Enabled: false, IdleConnectionTimeoutMinutes: 4},
+		}, infrastructureConfig.Networks.Zones[2])
+	})
+
+	t.Run("Should keep the config of legacy shoots without zones unchanged", func(t *testing.T) {
+		// given
+		existingConfig := []byte(`{"networks":{"vnet":{"cidr":"10.250.0.0/22"},"workers":"10.250.0.0/22"},"zoned":false}`)
+
+		// when
+		result, err := addZones(existingConfig, DefaultNodesCIDR, []string{"1"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, existingConfig, result)
+	})
+
+	t.Run("Should return error for an invalid zone name", func(t *testing.T) {
+		// given
+		existingConfig, err := GetInfrastructureConfig(DefaultNodesCIDR, []string{"1"})
+		require.NoError(t, err)
+
+		// when
+		_, err = addZones(existingConfig, DefaultNodesCIDR, []string{"1", "4"})
+
+		// then
+		require.EqualError(t, err, `invalid azure zone "4", supported zones are 1, 2 and 3`)
+	})
+}
@@ -0,0 +1,138 @@
+package azure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+const DefaultCloudProfileName = "az"
+
+func init() {
+	hyperscaler.Register(hyperscaler.TypeAzure, Provider{})
+}
+
+type Provider struct{}
+
+func (Provider) InfrastructureConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	// the Azure infrastructureConfig has no ranges for the secondary IP family
+	if hyperscaler.IsDualStack(opts.IPFamilies) {
+		return nil, fmt.Errorf("dual-stack networking is not supported for %s shoots", hyperscaler.TypeAzure)
+	}
+	// Azure shoots are all zoned, put probably it not be validated here.
+	infrastructureConfig, err := NewInfrastructureConfig(opts.WorkersCidr, opts.Zones)
+	if err != nil {
+		return nil, err
+	}
+	// the zone subnets are created in the existing VNet if it is referenced in the Runtime
+	if opts.Provider.Azure != nil && opts.Provider.Azure.VNet != nil {
+		infrastructureConfig.Networks.VNet = VNet{
+			Name:          ptr.To(opts.Provider.Azure.VNet.Name),
+			ResourceGroup: ptr.To(opts.Provider.Azure.VNet.ResourceGroup),
+		}
+	}
+	return json.Marshal(infrastructureConfig)
+}
+
+func (Provider) ControlPlaneConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	return GetControlPlaneConfig(opts.Zones)
+}
+
+func (Provider) WorkerConfig(_ hyperscaler.ConfigOpts) ([]byte, error) {
+	return nil, nil
+}
+
+func (Provider) Zones(infrastructureConfig, _ *runtime.RawExtension) ([]string, error) {
+	if infrastructureConfig == nil {
+		return nil, errors.New("infrastructureConfig is nil")
+	}
+
+	infraConfig, err := DecodeInfrastructureConfig(infrastructureConfig.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []string
+	for _, zone := range infraConfig.Networks.Zones {
+		zones = append(zones, fmt.Sprint(zone.Name))
+	}
+	return zones, nil
+}
+
+// The subnets of the new zones are carved from the parts of the VNet CIDR not used by the existing zones
+func (Provider) AddZones(infrastructureConfig *runtime.RawExtension, opts hyperscaler.ConfigOpts) (*runtime.RawExtension, error) {
+	if infrastructureConfig == nil {
+		return nil, nil
+	}
+
+	infraConfig, err := addZones(infrastructureConfig.Raw, opts.WorkersCidr, opts.Zones)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: infraConfig}, nil
+}
+
+func (p Provider) ValidateZones(workerZones []string, infrastructureConfig, controlPlaneConfig *runtime.RawExtension, patch bool) error {
+	infraConfigZones, err := p.Zones(infrastructureConfig, controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+
+	// workaround for legacy azure-lite shoots where networking zones are not specified in the infrastructureConfig
+	// such shoots are treated as correct, and we can skipp the validation of worker zones with infrastructureConfig zones
+	if patch && len(infraConfigZones) == 0 {
+		return nil
+	}
+
+	for _, zone := range workerZones {
+		if !slices.Contains(infraConfigZones, zone) {
+			return fmt.Errorf("one of workers is using networking zone not specified in the %s infrastructureConfig: %s", hyperscaler.TypeAzure, zone)
+		}
+	}
+	return nil
+}
+
+// ValidateRuntime checks the reference to the existing VNet, it is used only in the infrastructureConfig of the new shoot
+func (Provider) ValidateRuntime(shoot imv1.RuntimeShoot, fldPath *field.Path, patch bool) field.ErrorList {
+	if patch || shoot.Provider.Azure == nil || shoot.Provider.Azure.VNet == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	azurePath := fldPath.Child("provider", "azure")
+	vnet := shoot.Provider.Azure.VNet
+
+	if shoot.Provider.Type != hyperscaler.TypeAzure {
+		allErrs = append(allErrs, field.Forbidden(azurePath, fmt.Sprintf("can be set only for %s runtimes", hyperscaler.TypeAzure)))
+	}
+	if vnet.Name == "" {
+		allErrs = append(allErrs, field.Required(azurePath.Child("vnet", "name"), "must be set to use an existing VNet"))
+	}
+	if vnet.ResourceGroup == "" {
+		allErrs = append(allErrs, field.Required(azurePath.Child("vnet", "resourceGroup"), "must be set to use an existing VNet"))
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	return hyperscaler.ValidateExistingNetwork(shoot, fldPath, azurePath.Child("vnet"), vnet.CIDR, maxZones)
+}
+
+func (Provider) MaxZones() int {
+	return maxZones
+}
+
+func (Provider) CloudProfileName() string {
+	return DefaultCloudProfileName
+}
+
+func (Provider) ExposureClassName(_ hyperscaler.ConfigOpts) *string {
+	return nil
+}
@@ -1,37 +1,40 @@
 package azure
 
 import (
+	"fmt"
 	"math/big"
 	"net/netip"
+	"slices"
 	"strconv"
+
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
 )
 
-const defaultConnectionTimeOutMinutes = 4
-const workersBits = 3
-const cidrLength = 32
+const (
+	defaultConnectionTimeOutMinutes = 4
+	// maxZones is the number of zone subnets fitting in the nodes CIDR
+	maxZones = 1 << hyperscaler.ZoneSubnetBits
+)
 
-func generateAzureZones(workerCidr string, zoneNames []string) []Zone {
+// generateAzureZones carves the zone subnets from the workers CIDR, IPv4 and IPv6 CIDRs are supported
+func generateAzureZones(workerCidr string, zoneNames []string) ([]Zone, error) {
 	var zones []Zone
 
-	cidr, _ := netip.ParsePrefix(workerCidr)
-	workerPrefixLength := cidr.Bits() + workersBits
-	workerPrefix, _ := cidr.Addr().Prefix(workerPrefixLength)
-	// delta - it is the difference between CIDRs of two zones:
-	//    zone1:   "10.250.0.0/19",
-	//    zone2:   "10.250.32.0/19",
-	delta := big.NewInt(1)
-	delta.Lsh(delta, uint(cidrLength-workerPrefixLength))
+	zoneNumbers, err := convertZoneNames(zoneNames)
+	if err != nil {
+		return nil, err
+	}
 
-	// zoneIPValue - it is an integer, which is based on IP bytes
-	zoneIPValue := new(big.Int).SetBytes(workerPrefix.Addr().AsSlice())
+	cidr, err := hyperscaler.ParseZonesCIDR(workerCidr, len(zoneNumbers), maxZones)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, name := range convertZoneNames(zoneNames) {
-		zoneWorkerIP, _ := netip.AddrFromSlice(zoneIPValue.Bytes())
-		zoneWorkerCidr := netip.PrefixFrom(zoneWorkerIP, workerPrefixLength)
-		zoneIPValue.Add(zoneIPValue, delta)
+	subnets := zoneSubnets(cidr, len(zoneNumbers))
+	for i, name := range zoneNumbers {
 		zones = append(zones, Zone{
 			Name: name,
-			CIDR: zoneWorkerCidr.String(),
+			CIDR: subnets[i].String(),
 			NatGateway: &NatGateway{
 				// There are existing Azure clusters which were created before NAT gateway support,
 				// and they were migrated to HA with all zones having enableNatGateway: false .
@@ -41,18 +44,128 @@ func generateAzureZones(workerCidr string, zoneNames []string) []Zone {
 			},
 		})
 	}
-	return zones
+	return zones, nil
+}
+
+// zoneSubnets returns the first count consecutive zone subnets of the CIDR
+func zoneSubnets(cidr netip.Prefix, count int) []netip.Prefix {
+	var subnets []netip.Prefix
+
+	workerPrefixLength := cidr.Bits() + hyperscaler.ZoneSubnetBits
+	workerPrefix, _ := cidr.Addr().Prefix(workerPrefixLength)
+	cidrLength := cidr.Addr().BitLen()
+	// delta - it is the difference between CIDRs of two zones:
+	//    zone1:   "10.250.0.0/19",
+	//    zone2:   "10.250.32.0/19",
+	delta := big.NewInt(1)
+	delta.Lsh(delta, uint(cidrLength-workerPrefixLength))
+
+	// zoneIPValue - it is an integer, which is based on IP bytes
+	zoneIPValue := new(big.Int).SetBytes(workerPrefix.Addr().AsSlice())
+
+	for i := 0; i < count; i++ {
+		zoneWorkerIP := hyperscaler.AddrFromInt(zoneIPValue, cidrLength)
+		subnets = append(subnets, netip.PrefixFrom(zoneWorkerIP, workerPrefixLength))
+		zoneIPValue.Add(zoneIPValue, delta)
+	}
+	return subnets
 }
 
-func convertZoneNames(zoneNames []string) []int {
+// addZones appends the subnets of the zones missing in the zoned infrastructure config, the subnets of the existing zones are not changed.
+// The subnets are carved from the VNet CIDR, or from the nodes CIDR if the VNet CIDR is not set, the new zones get the first zone subnets
+// which are not used by the existing zones. The NAT gateway of the new zones is set up like the one of the first existing zone.
+func addZones(infrastructureConfig []byte, nodesCidr string, zoneNames []string) ([]byte, error) {
+	infraConfig, err := DecodeInfrastructureConfig(infrastructureConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// legacy azure-lite shoots have no zones in the infrastructureConfig, their zones are not managed
+	if !infraConfig.Zoned || len(infraConfig.Networks.Zones) == 0 {
+		return infrastructureConfig, nil
+	}
+
+	zoneNumbers, err := convertZoneNames(zoneNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var existingZones []int
+	var usedSubnets []netip.Prefix
+	for _, zone := range infraConfig.Networks.Zones {
+		existingZones = append(existingZones, zone.Name)
+
+		subnet, err := netip.ParsePrefix(zone.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet of the zone %d in the infrastructureConfig: %w", zone.Name, err)
+		}
+		usedSubnets = append(usedSubnets, subnet)
+	}
+
+	var newZones []int
+	for _, zone := range zoneNumbers {
+		if !slices.Contains(existingZones, zone) && !slices.Contains(newZones, zone) {
+			newZones = append(newZones, zone)
+		}
+	}
+
+	if len(newZones) == 0 {
+		return infrastructureConfig, nil
+	}
+
+	if infraConfig.Networks.VNet.CIDR != nil {
+		nodesCidr = *infraConfig.Networks.VNet.CIDR
+	}
+
+	cidr, err := hyperscaler.ParseZonesCIDR(nodesCidr, len(existingZones)+len(newZones), maxZones)
+	if err != nil {
+		return nil, err
+	}
+
+	natGateway := infraConfig.Networks.Zones[0].NatGateway
+
+	var addedZones []Zone
+	for _, subnet := range zoneSubnets(cidr, maxZones) {
+		if len(addedZones) == len(newZones) {
+			break
+		}
+
+		if !hyperscaler.SubnetsFree(cidr, []netip.Prefix{subnet}, usedSubnets) {
+			continue
+		}
+
+		zone := Zone{
+			Name: newZones[len(addedZones)],
+			CIDR: subnet.String(),
+		}
+		if natGateway != nil {
+			zone.NatGateway = &NatGateway{
+				Enabled:                      natGateway.Enabled,
+				IdleConnectionTimeoutMinutes: natGateway.IdleConnectionTimeoutMinutes,
+			}
+		}
+
+		addedZones = append(addedZones, zone)
+		usedSubnets = append(usedSubnets, subnet)
+	}
+
+	if len(addedZones) < len(newZones) {
+		return nil, fmt.Errorf("no free subnets left in the CIDR %s for the zone %d", cidr, newZones[len(addedZones)])
+	}
+
+	return hyperscaler.AppendZones(infrastructureConfig, addedZones)
+}
+
+// convertZoneNames converts the Azure zone names to zone numbers, names other than "1".."3" are rejected
+func convertZoneNames(zoneNames []string) ([]int, error) {
 	var zones []int
 	for _, inputZone := range zoneNames {
 		zone, err := strconv.Atoi(inputZone)
 		if err != nil || zone < 1 || zone > 3 {
-			continue
+			return nil, fmt.Errorf("invalid azure zone %q, supported zones are 1, 2 and 3", inputZone)
 		}
 		zones = append(zones, zone)
 	}
 
-	return zones
+	return zones, nil
 }
@@ -0,0 +1,55 @@
+package hyperscaler
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+)
+
+const (
+	// ZoneSubnetBits is the number of bits added to the nodes CIDR prefix length for the zone (workers) subnets
+	ZoneSubnetBits = 3
+	// MaxIPv4SubnetPrefixLength is the prefix length of the smallest IPv4 subnet the zone subnets are split into (AWS does not allow smaller subnets)
+	MaxIPv4SubnetPrefixLength = 28
+	// MaxIPv6SubnetPrefixLength is the prefix length of the smallest IPv6 subnet the zone subnets are split into
+	MaxIPv6SubnetPrefixLength = 64
+)
+
+// ParseZonesCIDR parses the nodes CIDR the zone subnets are carved from and checks if it is large enough for the number of zones.
+// Every zone gets a subnet with ZoneSubnetBits longer prefix, which may be split once more (AWS public and internal subnets).
+// The maxZones is the number of zones the provider can carve from the nodes CIDR.
+func ParseZonesCIDR(nodesCidr string, zoneCount, maxZones int) (netip.Prefix, error) {
+	cidr, err := netip.ParsePrefix(nodesCidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid nodes CIDR %q: %w", nodesCidr, err)
+	}
+
+	if cidr.Masked() != cidr {
+		return netip.Prefix{}, fmt.Errorf("nodes CIDR %s is not a network address, use %s", cidr, cidr.Masked())
+	}
+
+	if zoneCount > maxZones {
+		return netip.Prefix{}, fmt.Errorf("nodes CIDR %s cannot be split into %d zones, at most %d zones are supported", cidr, zoneCount, maxZones)
+	}
+
+	maxPrefixLength := MaxIPv4SubnetPrefixLength
+	if cidr.Addr().Is6() {
+		maxPrefixLength = MaxIPv6SubnetPrefixLength
+	}
+
+	if subnetPrefixLength := cidr.Bits() + ZoneSubnetBits + 1; subnetPrefixLength > maxPrefixLength {
+		return netip.Prefix{}, fmt.Errorf("nodes CIDR %s is too small for the zone subnets: the smallest subnet would be /%d, at most /%d is allowed, use a /%d or larger CIDR",
+			cidr, subnetPrefixLength, maxPrefixLength, maxPrefixLength-ZoneSubnetBits-1)
+	}
+
+	return cidr, nil
+}
+
+// AddrFromInt converts the integer to the IP address of the given bit length, the zero address is returned if the value does not fit
+func AddrFromInt(value *big.Int, bitLen int) netip.Addr {
+	if value.BitLen() > bitLen {
+		return netip.Addr{}
+	}
+	addr, _ := netip.AddrFromSlice(value.FillBytes(make([]byte, bitLen/8)))
+	return addr
+}
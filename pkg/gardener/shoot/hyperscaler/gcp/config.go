@@ -2,15 +2,20 @@ package gcp
 
 import (
 	"encoding/json"
+	"slices"
+	"strings"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/pkg/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 const (
 	infrastructureConfigKind = "InfrastructureConfig"
 	controlPlaneConfigKind   = "ControlPlaneConfig"
+	workerConfigKind         = "WorkerConfig"
 	apiVersion               = "gcp.provider.extensions.gardener.cloud/v1alpha1"
 )
 
@@ -41,6 +46,7 @@ func NewInfrastructureConfig(workerCIDR string) v1alpha1.InfrastructureConfig {
 	}
 }
 
+// The GCP controlPlaneConfig has a single zone, the first zone of the main worker is used. The workers can use all zones of its region.
 func NewControlPlaneConfig(zones []string) *v1alpha1.ControlPlaneConfig {
 	return &v1alpha1.ControlPlaneConfig{
 		TypeMeta: v1.TypeMeta{
@@ -51,6 +57,38 @@ func NewControlPlaneConfig(zones []string) *v1alpha1.ControlPlaneConfig {
 	}
 }
 
+// NewWorkerConfig returns nil if the Runtime has no GCP worker settings
+func NewWorkerConfig(worker *imv1.GCPWorker) *v1alpha1.WorkerConfig {
+	if worker == nil || (worker.ServiceAccount == nil && worker.LocalSSDInterface == "") {
+		return nil
+	}
+
+	workerConfig := &v1alpha1.WorkerConfig{
+		TypeMeta: v1.TypeMeta{
+			Kind:       workerConfigKind,
+			APIVersion: apiVersion,
+		},
+	}
+	if worker.ServiceAccount != nil {
+		workerConfig.ServiceAccount = &v1alpha1.ServiceAccount{
+			Email:  worker.ServiceAccount.Email,
+			Scopes: slices.Clone(worker.ServiceAccount.Scopes),
+		}
+	}
+	if worker.LocalSSDInterface != "" {
+		workerConfig.Volume = &v1alpha1.Volume{LocalSSDInterface: ptr.To(worker.LocalSSDInterface)}
+	}
+	return workerConfig
+}
+
+// Region returns the region of the GCP zone, for example europe-west3 for europe-west3-a
+func Region(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
+
 func DecodeControlPlaneConfig(data []byte) (*v1alpha1.ControlPlaneConfig, error) {
 	controlPlaneConfig := &v1alpha1.ControlPlaneConfig{}
 	err := json.Unmarshal(data, controlPlaneConfig)
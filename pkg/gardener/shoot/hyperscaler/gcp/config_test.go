@@ -5,8 +5,11 @@ import (
 	"testing"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func TestControlPlaneConfig(t *testing.T) {
@@ -57,3 +60,51 @@ func TestInfrastructureConfig(t *testing.T) {
 		assert.Equal(t, "10.250.0.0/22", infrastructureConfig.Networks.Worker)
 	})
 }
+
+func TestWorkerConfig(t *testing.T) {
+	t.Run("Create Worker config with service account and local SSD interface", func(t *testing.T) {
+		// when
+		workerConfig := NewWorkerConfig(&imv1.GCPWorker{
+			ServiceAccount:    &imv1.GCPServiceAccount{Email: "workers@project.iam.gserviceaccount.com", Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"}},
+			LocalSSDInterface: "SCSI",
+		})
+
+		// then
+		require.NotNil(t, workerConfig)
+		assert.Equal(t, apiVersion, workerConfig.TypeMeta.APIVersion)
+		assert.Equal(t, workerConfigKind, workerConfig.TypeMeta.Kind)
+		assert.Equal(t, "workers@project.iam.gserviceaccount.com", workerConfig.ServiceAccount.Email)
+		assert.Equal(t, []string{"https://www.googleapis.com/auth/cloud-platform"}, workerConfig.ServiceAccount.Scopes)
+		assert.Equal(t, ptr.To("SCSI"), workerConfig.Volume.LocalSSDInterface)
+	})
+
+	t.Run("Return nil when no worker settings are provided", func(t *testing.T) {
+		assert.Nil(t, NewWorkerConfig(nil))
+		assert.Nil(t, NewWorkerConfig(&imv1.GCPWorker{}))
+	})
+}
+
+func TestRegion(t *testing.T) {
+	assert.Equal(t, "europe-west3", Region("europe-west3-a"))
+	assert.Equal(t, "us-central1", Region("us-central1-f"))
+	assert.Equal(t, "zone", Region("zone"))
+}
+
+func TestValidateZones(t *testing.T) {
+	controlPlaneConfig := &runtime.RawExtension{Raw: []byte(`{"zone":"europe-west3-a"}`)}
+
+	t.Run("Workers can use all zones of the region of the controlPlaneConfig zone", func(t *testing.T) {
+		err := Provider{}.ValidateZones([]string{"europe-west3-b", "europe-west3-a", "europe-west3-c"}, nil, controlPlaneConfig, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("Return error when no worker uses the controlPlaneConfig zone", func(t *testing.T) {
+		err := Provider{}.ValidateZones([]string{"europe-west3-b"}, nil, controlPlaneConfig, false)
+		require.EqualError(t, err, "none of workers is using networking zone specified in the controlPlaneConfig: europe-west3-a")
+	})
+
+	t.Run("Return error when a worker uses a zone of another region", func(t *testing.T) {
+		err := Provider{}.ValidateZones([]string{"europe-west3-a", "europe-west4-a"}, nil, controlPlaneConfig, true)
+		require.EqualError(t, err, "one of workers is using networking zone europe-west4-a outside of the region europe-west3 of the controlPlaneConfig zone europe-west3-a")
+	})
+}
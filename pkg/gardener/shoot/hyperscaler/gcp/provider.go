@@ -0,0 +1,109 @@
+package gcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const DefaultCloudProfileName = "gcp"
+
+func init() {
+	hyperscaler.Register(hyperscaler.TypeGCP, Provider{})
+}
+
+type Provider struct{}
+
+// The Cloud NAT and internal subnet settings of the Runtime are set only in the generated infrastructureConfig of new shoots
+func (Provider) InfrastructureConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	infrastructureConfig := NewInfrastructureConfig(opts.WorkersCidr)
+
+	if gcp := opts.Provider.GCP; gcp != nil {
+		if gcp.CloudNAT != nil {
+			infrastructureConfig.Networks.CloudNAT = &v1alpha1.CloudNAT{MinPortsPerVM: gcp.CloudNAT.MinPortsPerVM}
+			if gcp.CloudNAT.EndpointIndependentMapping {
+				infrastructureConfig.Networks.CloudNAT.EndpointIndependentMapping = &v1alpha1.EndpointIndependentMapping{Enabled: true}
+			}
+		}
+		if gcp.Internal != "" {
+			infrastructureConfig.Networks.Internal = ptr.To(gcp.Internal)
+		}
+	}
+	return json.Marshal(infrastructureConfig)
+}
+
+func (Provider) ControlPlaneConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	return GetControlPlaneConfig(opts.Zones)
+}
+
+func (Provider) WorkerConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	if opts.Provider.GCP == nil {
+		return nil, nil
+	}
+
+	workerConfig := NewWorkerConfig(opts.Provider.GCP.Worker)
+	if workerConfig == nil {
+		return nil, nil
+	}
+	return json.Marshal(workerConfig)
+}
+
+// GCP keeps the networking zone in the controlPlaneConfig
+func (Provider) Zones(_, controlPlaneConfig *runtime.RawExtension) ([]string, error) {
+	if controlPlaneConfig == nil {
+		return nil, errors.New("controlPlaneConfig is nil")
+	}
+
+	ctrlPlaneConfig, err := DecodeControlPlaneConfig(controlPlaneConfig.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return []string{ctrlPlaneConfig.Zone}, nil
+}
+
+// The GCP infrastructureConfig has a single workers subnet used by all zones, no zones are added
+func (Provider) AddZones(infrastructureConfig *runtime.RawExtension, _ hyperscaler.ConfigOpts) (*runtime.RawExtension, error) {
+	return infrastructureConfig, nil
+}
+
+func (p Provider) ValidateZones(workerZones []string, infrastructureConfig, controlPlaneConfig *runtime.RawExtension, _ bool) error {
+	ctrlPlaneZones, err := p.Zones(infrastructureConfig, controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+
+	if len(ctrlPlaneZones) == 0 {
+		return fmt.Errorf("cannot validate workers zones against GCP controlPlaneConfig, cannot read current networking zone")
+	}
+
+	if !slices.Contains(workerZones, ctrlPlaneZones[0]) {
+		return fmt.Errorf("none of workers is using networking zone specified in the controlPlaneConfig: %s", ctrlPlaneZones[0])
+	}
+
+	// the shoot is regional, the workers can use any zone of the region of the controlPlaneConfig zone
+	region := Region(ctrlPlaneZones[0])
+	for _, zone := range workerZones {
+		if Region(zone) != region {
+			return fmt.Errorf("one of workers is using networking zone %s outside of the region %s of the controlPlaneConfig zone %s", zone, region, ctrlPlaneZones[0])
+		}
+	}
+	return nil
+}
+
+func (Provider) MaxZones() int {
+	return 0
+}
+
+func (Provider) CloudProfileName() string {
+	return DefaultCloudProfileName
+}
+
+func (Provider) ExposureClassName(_ hyperscaler.ConfigOpts) *string {
+	return nil
+}
@@ -0,0 +1,93 @@
+package gcp
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var localSSDInterfaces = []string{"NVME", "SCSI"}
+
+// ValidateRuntime checks the GCP specific settings of the Runtime. The internal subnet must not overlap with the Runtime networking CIDRs,
+// the Cloud NAT, service account and volume settings are checked the same way as by the Gardener GCP extension.
+func (Provider) ValidateRuntime(shoot imv1.RuntimeShoot, fldPath *field.Path, _ bool) field.ErrorList {
+	gcp := shoot.Provider.GCP
+	if gcp == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	gcpPath := fldPath.Child("provider", "gcp")
+
+	if shoot.Provider.Type != hyperscaler.TypeGCP {
+		return field.ErrorList{field.Forbidden(gcpPath, fmt.Sprintf("can be set only for %s runtimes", hyperscaler.TypeGCP))}
+	}
+
+	if gcp.Internal != "" {
+		allErrs = append(allErrs, validateInternalCIDR(gcp.Internal, shoot.Networking, gcpPath.Child("internal"))...)
+	}
+
+	if gcp.CloudNAT != nil && gcp.CloudNAT.MinPortsPerVM != nil {
+		if minPorts := *gcp.CloudNAT.MinPortsPerVM; minPorts < 1 || minPorts > 65536 {
+			allErrs = append(allErrs, field.Invalid(gcpPath.Child("cloudNAT", "minPortsPerVM"), minPorts, "must be between 1 and 65536"))
+		}
+	}
+
+	if gcp.Worker != nil {
+		workerPath := gcpPath.Child("worker")
+
+		if sa := gcp.Worker.ServiceAccount; sa != nil {
+			if sa.Email == "" {
+				allErrs = append(allErrs, field.Required(workerPath.Child("serviceAccount", "email"), "must be set when providing service account"))
+			}
+			if len(sa.Scopes) == 0 {
+				allErrs = append(allErrs, field.Required(workerPath.Child("serviceAccount", "scopes"), "must have at least one scope"))
+			}
+			for i, scope := range sa.Scopes {
+				if scope == "" {
+					allErrs = append(allErrs, field.Required(workerPath.Child("serviceAccount", "scopes").Index(i), "must not be empty"))
+				} else if slices.Contains(sa.Scopes[:i], scope) {
+					allErrs = append(allErrs, field.Duplicate(workerPath.Child("serviceAccount", "scopes").Index(i), scope))
+				}
+			}
+		}
+
+		if localSSDInterface := gcp.Worker.LocalSSDInterface; localSSDInterface != "" && !slices.Contains(localSSDInterfaces, localSSDInterface) {
+			allErrs = append(allErrs, field.NotSupported(workerPath.Child("localSSDInterface"), localSSDInterface, localSSDInterfaces))
+		}
+	}
+
+	return allErrs
+}
+
+// validateInternalCIDR checks if the internal subnet is an IPv4 network address not overlapping with the Runtime networking CIDRs
+func validateInternalCIDR(internal string, networking imv1.Networking, fldPath *field.Path) field.ErrorList {
+	prefix, err := netip.ParsePrefix(internal)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, internal, fmt.Sprintf("must be a valid CIDR: %v", err))}
+	}
+	if prefix.Masked() != prefix {
+		return field.ErrorList{field.Invalid(fldPath, internal, fmt.Sprintf("must be a network address, use %s", prefix.Masked()))}
+	}
+	if !prefix.Addr().Is4() {
+		return field.ErrorList{field.Invalid(fldPath, internal, "must be an IPv4 CIDR")}
+	}
+
+	var allErrs field.ErrorList
+	for _, cidrField := range []struct{ name, cidr string }{
+		{"pods", networking.Pods},
+		{"nodes", networking.Nodes},
+		{"services", networking.Services},
+	} {
+		// invalid networking CIDRs are reported by the networking validation
+		other, err := netip.ParsePrefix(cidrField.cidr)
+		if err == nil && other.Overlaps(prefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath, internal, fmt.Sprintf("overlaps with the %s CIDR %s", cidrField.name, other)))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,75 @@
+package hyperscaler
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateNodesCapacity checks if the zone subnets can be carved from the nodes CIDR, it is used by the providers carving zone subnets.
+// The maxZones is the number of zones the provider can carve from the nodes CIDR. The check is skipped when the infrastructureConfig is specified in the Runtime.
+func ValidateNodesCapacity(shoot imv1.RuntimeShoot, fldPath *field.Path, maxZones int) field.ErrorList {
+	if shoot.Provider.InfrastructureConfig != nil || shoot.Networking.Nodes == "" {
+		return nil
+	}
+
+	if _, err := ParseZonesCIDR(shoot.Networking.Nodes, len(WorkerZones(shoot.Provider)), maxZones); err != nil {
+		return field.ErrorList{field.Invalid(fldPath.Child("networking", "nodes"), shoot.Networking.Nodes, err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateExistingNetwork checks the Runtime referencing an existing network, the zone subnets are created in the existing network from the nodes CIDR.
+// The nodes CIDR is required, must fit the zone subnets and must be inside the CIDR of the existing network if it is given.
+func ValidateExistingNetwork(shoot imv1.RuntimeShoot, fldPath, referencePath *field.Path, networkCIDR string, maxZones int) field.ErrorList {
+	// the references are rendered only into the generated infrastructureConfig
+	if shoot.Provider.InfrastructureConfig != nil {
+		return field.ErrorList{field.Forbidden(referencePath, "cannot be set together with the infrastructureConfig")}
+	}
+
+	nodesPath := fldPath.Child("networking", "nodes")
+	if shoot.Networking.Nodes == "" {
+		return field.ErrorList{field.Required(nodesPath, "must be set to create the zone subnets in the existing network")}
+	}
+
+	allErrs := ValidateNodesCapacity(shoot, fldPath, maxZones)
+
+	if networkCIDR != "" {
+		network, err := netip.ParsePrefix(networkCIDR)
+		if err != nil {
+			return append(allErrs, field.Invalid(referencePath.Child("cidr"), networkCIDR, fmt.Sprintf("must be a valid CIDR: %v", err)))
+		}
+
+		// an invalid nodes CIDR is reported by the networking validation
+		nodes, err := netip.ParsePrefix(shoot.Networking.Nodes)
+		if err == nil && !SubnetsFree(network.Masked(), []netip.Prefix{nodes}, nil) {
+			allErrs = append(allErrs, field.Invalid(nodesPath, shoot.Networking.Nodes, fmt.Sprintf("must be inside the CIDR %s of the existing network", network)))
+		}
+	}
+
+	return allErrs
+}
+
+// WorkerZones returns the distinct zones of the workers and additional workers
+func WorkerZones(provider imv1.Provider) []string {
+	var zones []string
+
+	workers := provider.Workers
+	if provider.AdditionalWorkers != nil {
+		workers = append(slices.Clone(workers), *provider.AdditionalWorkers...)
+	}
+
+	for _, worker := range workers {
+		for _, zone := range worker.Zones {
+			if !slices.Contains(zones, zone) {
+				zones = append(zones, zone)
+			}
+		}
+	}
+
+	return zones
+}
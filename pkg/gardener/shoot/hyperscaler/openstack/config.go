@@ -16,32 +16,32 @@ const (
 )
 
 func GetInfrastructureConfig(workerCIDR string, _ []string) ([]byte, error) {
-	return json.Marshal(NewInfrastructureConfig(workerCIDR))
+	return json.Marshal(NewInfrastructureConfig(workerCIDR, defaultFloatingPoolName))
 }
 
 func GetControlPlaneConfig(_ []string) ([]byte, error) {
-	return json.Marshal(NewControlPlaneConfig())
+	return json.Marshal(NewControlPlaneConfig(defaultLoadBalancerProvider))
 }
 
-func NewInfrastructureConfig(workerCIDR string) v1alpha1.InfrastructureConfig {
+func NewInfrastructureConfig(workerCIDR, floatingPoolName string) v1alpha1.InfrastructureConfig {
 	return v1alpha1.InfrastructureConfig{
 		TypeMeta: v1.TypeMeta{
 			Kind:       infrastructureConfigKind,
 			APIVersion: apiVersion,
 		},
-		FloatingPoolName: defaultFloatingPoolName,
+		FloatingPoolName: floatingPoolName,
 		Networks: v1alpha1.Networks{
 			Workers: workerCIDR,
 		},
 	}
 }
 
-func NewControlPlaneConfig() *v1alpha1.ControlPlaneConfig {
+func NewControlPlaneConfig(loadBalancerProvider string) *v1alpha1.ControlPlaneConfig {
 	return &v1alpha1.ControlPlaneConfig{
 		TypeMeta: v1.TypeMeta{
 			Kind:       controlPlaneConfigKind,
 			APIVersion: apiVersion,
 		},
-		LoadBalancerProvider: defaultLoadBalancerProvider,
+		LoadBalancerProvider: loadBalancerProvider,
 	}
 }
@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"encoding/json"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	DefaultCloudProfileName  = "converged-cloud-kyma"
+	DefaultExposureClassName = "converged-cloud-internet"
+)
+
+func init() {
+	hyperscaler.Register(hyperscaler.TypeOpenStack, Provider{})
+}
+
+type Provider struct{}
+
+func (Provider) InfrastructureConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	return json.Marshal(NewInfrastructureConfig(opts.WorkersCidr, Settings(opts).FloatingPoolName))
+}
+
+func (Provider) ControlPlaneConfig(opts hyperscaler.ConfigOpts) ([]byte, error) {
+	return json.Marshal(NewControlPlaneConfig(Settings(opts).LoadBalancerProvider))
+}
+
+func (Provider) WorkerConfig(_ hyperscaler.ConfigOpts) ([]byte, error) {
+	return nil, nil
+}
+
+// OpenStack provider configs do not contain networking zones
+func (Provider) Zones(_, _ *runtime.RawExtension) ([]string, error) {
+	return nil, nil
+}
+
+func (Provider) AddZones(infrastructureConfig *runtime.RawExtension, _ hyperscaler.ConfigOpts) (*runtime.RawExtension, error) {
+	return infrastructureConfig, nil
+}
+
+func (Provider) ValidateZones(_ []string, _, _ *runtime.RawExtension, _ bool) error {
+	return nil
+}
+
+func (Provider) ValidateRuntime(_ imv1.RuntimeShoot, _ *field.Path, _ bool) field.ErrorList {
+	return nil
+}
+
+func (Provider) MaxZones() int {
+	return 0
+}
+
+func (Provider) CloudProfileName() string {
+	return DefaultCloudProfileName
+}
+
+// ExposureClassName is required only for OpenStack
+func (Provider) ExposureClassName(opts hyperscaler.ConfigOpts) *string {
+	return ptr.To(Settings(opts).ExposureClassName)
+}
+
+// Settings resolves the OpenStack settings, the values from the Runtime CR take precedence over the region settings,
+// the region settings take precedence over the converter config defaults, and the built-in defaults are used for the values not set anywhere
+func Settings(opts hyperscaler.ConfigOpts) config.OpenStackSettings {
+	defaults := config.OpenStackSettings{
+		FloatingPoolName:     defaultFloatingPoolName,
+		LoadBalancerProvider: defaultLoadBalancerProvider,
+		ExposureClassName:    DefaultExposureClassName,
+	}
+
+	settings := defaults.Merge(opts.Config.OpenStack.ForRegion(opts.Region))
+
+	return settings.Merge(runtimeSettings(opts.Provider.OpenStack))
+}
+
+func runtimeSettings(openStack *imv1.OpenStackProvider) config.OpenStackSettings {
+	if openStack == nil {
+		return config.OpenStackSettings{}
+	}
+
+	return config.OpenStackSettings{
+		FloatingPoolName:     openStack.FloatingPoolName,
+		LoadBalancerProvider: openStack.LoadBalancerProvider,
+		ExposureClassName:    openStack.ExposureClassName,
+	}
+}
@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettings(t *testing.T) {
+	providerConfig := config.ProviderConfig{
+		OpenStack: config.OpenStackConfig{
+			OpenStackSettings: config.OpenStackSettings{
+				FloatingPoolName: "FloatingIP-external-default",
+			},
+			Regions: map[string]config.OpenStackSettings{
+				"eu-de-2": {
+					FloatingPoolName:  "FloatingIP-external-eu-de-2",
+					ExposureClassName: "eu-de-2-internet",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range []struct {
+		name     string
+		opts     hyperscaler.ConfigOpts
+		expected config.OpenStackSettings
+	}{
+		{
+			name: "Should use built-in defaults when nothing is configured",
+			opts: hyperscaler.ConfigOpts{Region: "eu-de-1"},
+			expected: config.OpenStackSettings{
+				FloatingPoolName:     defaultFloatingPoolName,
+				LoadBalancerProvider: defaultLoadBalancerProvider,
+				ExposureClassName:    DefaultExposureClassName,
+			},
+		},
+		{
+			name: "Should use configured defaults for region with no settings",
+			opts: hyperscaler.ConfigOpts{Region: "eu-de-1", Config: providerConfig},
+			expected: config.OpenStackSettings{
+				FloatingPoolName:     "FloatingIP-external-default",
+				LoadBalancerProvider: defaultLoadBalancerProvider,
+				ExposureClassName:    DefaultExposureClassName,
+			},
+		},
+		{
+			name: "Should use region settings",
+			opts: hyperscaler.ConfigOpts{Region: "eu-de-2", Config: providerConfig},
+			expected: config.OpenStackSettings{
+				FloatingPoolName:     "FloatingIP-external-eu-de-2",
+				LoadBalancerProvider: defaultLoadBalancerProvider,
+				ExposureClassName:    "eu-de-2-internet",
+			},
+		},
+		{
+			name: "Should use Runtime CR settings",
+			opts: hyperscaler.ConfigOpts{
+				Region: "eu-de-2",
+				Config: providerConfig,
+				Provider: imv1.Provider{
+					OpenStack: &imv1.OpenStackProvider{LoadBalancerProvider: "octavia", ExposureClassName: "runtime-internet"},
+				},
+			},
+			expected: config.OpenStackSettings{
+				FloatingPoolName:     "FloatingIP-external-eu-de-2",
+				LoadBalancerProvider: "octavia",
+				ExposureClassName:    "runtime-internet",
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, Settings(testCase.opts))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package hyperscaler
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Provider contains the hyperscaler specific logic used by the converter.
+// Implementations register themselves with Register, usually from the init function of the provider package.
+type Provider interface {
+	// InfrastructureConfig generates the provider infrastructureConfig for the workers CIDR and networking zones
+	InfrastructureConfig(opts ConfigOpts) ([]byte, error)
+	// ControlPlaneConfig generates the provider controlPlaneConfig for the networking zones
+	ControlPlaneConfig(opts ConfigOpts) ([]byte, error)
+	// WorkerConfig generates the worker providerConfig, nil is returned if the provider does not need one
+	WorkerConfig(opts ConfigOpts) ([]byte, error)
+	// Zones reads the current set of networking zones from the provider configs
+	Zones(infrastructureConfig, controlPlaneConfig *runtime.RawExtension) ([]string, error)
+	// AddZones adds the networking zones missing in the existing infrastructureConfig, the existing zones are not changed
+	AddZones(infrastructureConfig *runtime.RawExtension, opts ConfigOpts) (*runtime.RawExtension, error)
+	// ValidateZones checks if the zones used by the workers match the provider configs
+	ValidateZones(workerZones []string, infrastructureConfig, controlPlaneConfig *runtime.RawExtension, patch bool) error
+	// ValidateRuntime checks the provider specific settings of the Runtime, the settings are rejected if the Runtime has other provider type.
+	// The settings used only for the new shoots are not validated when the shoot is patched.
+	ValidateRuntime(shoot imv1.RuntimeShoot, fldPath *field.Path, patch bool) field.ErrorList
+	// MaxZones returns the number of zone subnets which can be carved from the nodes CIDR, 0 if the zone subnets are not carved from it
+	MaxZones() int
+	// CloudProfileName returns the name of the Gardener CloudProfile used by the shoots
+	CloudProfileName() string
+	// ExposureClassName returns the exposure class set on the shoots, nil if not required
+	ExposureClassName(opts ConfigOpts) *string
+}
+
+// ConfigOpts contains the data the provider configs are generated from
+type ConfigOpts struct {
+	WorkersCidr string
+	Zones       []string
+	Region      string
+	IPFamilies  []gardener.IPFamily
+	// Config is the provider configuration from the converter config
+	Config config.ProviderConfig
+	// Provider is the provider section of the Runtime CR, it may contain provider specific overrides
+	Provider imv1.Provider
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]Provider{}
+)
+
+// Register adds the provider to the registry, the provider registered before for the same type is replaced
+func Register(providerType string, provider Provider) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	registry[providerType] = provider
+}
+
+func Get(providerType string) (Provider, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	provider, found := registry[providerType]
+	if !found {
+		return nil, fmt.Errorf("provider not supported: %s", providerType)
+	}
+	return provider, nil
+}
+
+// Types returns the sorted list of registered provider types
+func Types() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	types := make([]string, 0, len(registry))
+	for providerType := range registry {
+		types = append(types, providerType)
+	}
+	slices.Sort(types)
+
+	return types
+}
+
+// IsDualStack returns true if both IPv4 and IPv6 families are used
+func IsDualStack(ipFamilies []gardener.IPFamily) bool {
+	return slices.Contains(ipFamilies, gardener.IPFamilyIPv4) && slices.Contains(ipFamilies, gardener.IPFamilyIPv6)
+}
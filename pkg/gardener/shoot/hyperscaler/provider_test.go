@@ -0,0 +1,286 @@
+package hyperscaler_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	awsext "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/gcp"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/openstack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("Should return built-in providers", func(t *testing.T) {
+		// when
+		provider, err := hyperscaler.Get(hyperscaler.TypeAWS)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, aws.Provider{}, provider)
+		assert.Subset(t, hyperscaler.Types(), []string{hyperscaler.TypeAWS, hyperscaler.TypeAzure, hyperscaler.TypeGCP, hyperscaler.TypeOpenStack})
+	})
+
+	t.Run("Should return error for not registered provider", func(t *testing.T) {
+		// when
+		_, err := hyperscaler.Get("unknown")
+
+		// then
+		require.EqualError(t, err, "provider not supported: unknown")
+	})
+
+	t.Run("Should register new provider", func(t *testing.T) {
+		// when
+		hyperscaler.Register("local", localProvider{})
+		provider, err := hyperscaler.Get("local")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "local", provider.CloudProfileName())
+		assert.Contains(t, hyperscaler.Types(), "local")
+	})
+}
+
+func TestBuiltInProviders(t *testing.T) {
+	for _, testCase := range []struct {
+		providerType              string
+		expectedCloudProfileName  string
+		expectedExposureClassName *string
+		expectedMaxZones          int
+	}{
+		{providerType: hyperscaler.TypeAWS, expectedCloudProfileName: "aws", expectedMaxZones: 4},
+		{providerType: hyperscaler.TypeAzure, expectedCloudProfileName: "az", expectedMaxZones: 8},
+		{providerType: hyperscaler.TypeGCP, expectedCloudProfileName: "gcp"},
+		{providerType: hyperscaler.TypeOpenStack, expectedCloudProfileName: "converged-cloud-kyma", expectedExposureClassName: ptr.To("converged-cloud-internet")},
+	} {
+		t.Run(testCase.providerType, func(t *testing.T) {
+			// when
+			provider, err := hyperscaler.Get(testCase.providerType)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCloudProfileName, provider.CloudProfileName())
+			assert.Equal(t, testCase.expectedExposureClassName, provider.ExposureClassName(hyperscaler.ConfigOpts{}))
+			assert.Equal(t, testCase.expectedMaxZones, provider.MaxZones())
+		})
+	}
+}
+
+func TestProviderZones(t *testing.T) {
+	t.Run("Should validate AWS worker zones against infrastructureConfig", func(t *testing.T) {
+		// given
+		provider, err := hyperscaler.Get(hyperscaler.TypeAWS)
+		require.NoError(t, err)
+
+		infraConfig, err := provider.InfrastructureConfig(hyperscaler.ConfigOpts{WorkersCidr: "10.250.0.0/16", Zones: []string{"eu-central-1a", "eu-central-1b"}})
+		require.NoError(t, err)
+		infrastructureConfig := &runtime.RawExtension{Raw: infraConfig}
+
+		// when
+		zones, zonesErr := provider.Zones(infrastructureConfig, nil)
+		validErr := provider.ValidateZones([]string{"eu-central-1b"}, infrastructureConfig, nil, false)
+		invalidErr := provider.ValidateZones([]string{"eu-central-1c"}, infrastructureConfig, nil, false)
+
+		// then
+		require.NoError(t, zonesErr)
+		assert.Equal(t, []string{"eu-central-1a", "eu-central-1b"}, zones)
+		require.NoError(t, validErr)
+		require.EqualError(t, invalidErr, "one of workers is using networking zone not specified in the aws infrastructureConfig: eu-central-1c")
+	})
+
+	t.Run("Should skip Azure zones validation for legacy shoots with no zones on patch", func(t *testing.T) {
+		// given
+		provider, err := hyperscaler.Get(hyperscaler.TypeAzure)
+		require.NoError(t, err)
+
+		infrastructureConfig := &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig"}`)}
+
+		// when
+		patchErr := provider.ValidateZones([]string{"1"}, infrastructureConfig, nil, true)
+		createErr := provider.ValidateZones([]string{"1"}, infrastructureConfig, nil, false)
+
+		// then
+		require.NoError(t, patchErr)
+		require.Error(t, createErr)
+	})
+
+	t.Run("Should validate GCP worker zones against controlPlaneConfig", func(t *testing.T) {
+		// given
+		provider, err := hyperscaler.Get(hyperscaler.TypeGCP)
+		require.NoError(t, err)
+
+		ctrlPlaneConfig, err := provider.ControlPlaneConfig(hyperscaler.ConfigOpts{Zones: []string{"europe-west3-a"}})
+		require.NoError(t, err)
+		controlPlaneConfig := &runtime.RawExtension{Raw: ctrlPlaneConfig}
+
+		// when
+		validErr := provider.ValidateZones([]string{"europe-west3-a", "europe-west3-b"}, nil, controlPlaneConfig, false)
+		invalidErr := provider.ValidateZones([]string{"europe-west3-b"}, nil, controlPlaneConfig, false)
+
+		// then
+		require.NoError(t, validErr)
+		require.EqualError(t, invalidErr, "none of workers is using networking zone specified in the controlPlaneConfig: europe-west3-a")
+	})
+}
+
+func TestProviderDualStack(t *testing.T) {
+	dualStack := []gardener.IPFamily{gardener.IPFamilyIPv4, gardener.IPFamilyIPv6}
+
+	t.Run("Should enable dual-stack in AWS infrastructureConfig", func(t *testing.T) {
+		// given
+		provider, err := hyperscaler.Get(hyperscaler.TypeAWS)
+		require.NoError(t, err)
+
+		// when
+		dualStackConfig, dualStackErr := provider.InfrastructureConfig(hyperscaler.ConfigOpts{WorkersCidr: "10.250.0.0/16", Zones: []string{"eu-central-1a"}, IPFamilies: dualStack})
+		singleStackConfig, singleStackErr := provider.InfrastructureConfig(hyperscaler.ConfigOpts{WorkersCidr: "10.250.0.0/16", Zones: []string{"eu-central-1a"}})
+
+		// then
+		require.NoError(t, dualStackErr)
+		require.NoError(t, singleStackErr)
+
+		var dualStackInfraConfig, singleStackInfraConfig awsext.InfrastructureConfig
+		require.NoError(t, json.Unmarshal(dualStackConfig, &dualStackInfraConfig))
+		require.NoError(t, json.Unmarshal(singleStackConfig, &singleStackInfraConfig))
+		assert.Equal(t, &awsext.DualStack{Enabled: true}, dualStackInfraConfig.DualStack)
+		assert.Equal(t, "10.250.0.0/19", dualStackInfraConfig.Networks.Zones[0].Workers)
+		assert.Nil(t, singleStackInfraConfig.DualStack)
+	})
+
+	t.Run("Should reject dual-stack for Azure", func(t *testing.T) {
+		// given
+		provider, err := hyperscaler.Get(hyperscaler.TypeAzure)
+		require.NoError(t, err)
+
+		// when
+		_, err = provider.InfrastructureConfig(hyperscaler.ConfigOpts{WorkersCidr: "10.250.0.0/16", Zones: []string{"1"}, IPFamilies: dualStack})
+
+		// then
+		require.EqualError(t, err, "dual-stack networking is not supported for azure shoots")
+	})
+
+	t.Run("Should detect dual-stack", func(t *testing.T) {
+		assert.True(t, hyperscaler.IsDualStack(dualStack))
+		assert.True(t, hyperscaler.IsDualStack([]gardener.IPFamily{gardener.IPFamilyIPv6, gardener.IPFamilyIPv4}))
+		assert.False(t, hyperscaler.IsDualStack([]gardener.IPFamily{gardener.IPFamilyIPv6}))
+		assert.False(t, hyperscaler.IsDualStack(nil))
+	})
+}
+
+func TestProviderExistingNetwork(t *testing.T) {
+	t.Run("Should use the existing AWS VPC", func(t *testing.T) {
+		// given
+		provider, err := hyperscaler.Get(hyperscaler.TypeAWS)
+		require.NoError(t, err)
+
+		// when
+		infraConfigBytes, err := provider.InfrastructureConfig(hyperscaler.ConfigOpts{
+			WorkersCidr: "10.250.0.0/22",
+			Zones:       []string{"eu-central-1a"},
+			Provider:    imv1.Provider{AWS: &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "vpc-0123456789abcdef0", CIDR: "10.250.0.0/16"}}},
+		})
+
+		// then
+		require.NoError(t, err)
+
+		var infraConfig awsext.InfrastructureConfig
+		require.NoError(t, json.Unmarshal(infraConfigBytes, &infraConfig))
+		assert.Equal(t, awsext.VPC{ID: ptr.To("vpc-0123456789abcdef0")}, infraConfig.Networks.VPC)
+		assert.Equal(t, "10.250.0.0/25", infraConfig.Networks.Zones[0].Workers)
+	})
+
+	t.Run("Should use the existing Azure VNet", func(t *testing.T) {
+		// given
+		provider, err := hyperscaler.Get(hyperscaler.TypeAzure)
+		require.NoError(t, err)
+
+		// when
+		infraConfigBytes, err := provider.InfrastructureConfig(hyperscaler.ConfigOpts{
+			WorkersCidr: "10.250.0.0/22",
+			Zones:       []string{"1"},
+			Provider:    imv1.Provider{Azure: &imv1.AzureProvider{VNet: &imv1.AzureVNet{Name: "vnet", ResourceGroup: "group"}}},
+		})
+
+		// then
+		require.NoError(t, err)
+
+		var infraConfig azure.InfrastructureConfig
+		require.NoError(t, json.Unmarshal(infraConfigBytes, &infraConfig))
+		assert.Equal(t, azure.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("group")}, infraConfig.Networks.VNet)
+		assert.Equal(t, "10.250.0.0/25", infraConfig.Networks.Zones[0].CIDR)
+	})
+}
+
+func TestProviderWorkerConfig(t *testing.T) {
+	// given
+	awsProvider, err := hyperscaler.Get(hyperscaler.TypeAWS)
+	require.NoError(t, err)
+	gcpProvider, err := hyperscaler.Get(hyperscaler.TypeGCP)
+	require.NoError(t, err)
+
+	// when
+	withIMDSv2 := hyperscaler.ConfigOpts{Config: config.ProviderConfig{AWS: config.AWSConfig{EnableIMDSv2: true}}}
+	awsWithIMDSv2, awsErr := awsProvider.WorkerConfig(withIMDSv2)
+	awsWithoutIMDSv2, _ := awsProvider.WorkerConfig(hyperscaler.ConfigOpts{})
+	gcpWorkerConfig, gcpErr := gcpProvider.WorkerConfig(withIMDSv2)
+
+	// then
+	require.NoError(t, awsErr)
+	assert.Contains(t, string(awsWithIMDSv2), `"kind":"WorkerConfig"`)
+	assert.Nil(t, awsWithoutIMDSv2)
+	require.NoError(t, gcpErr)
+	assert.Nil(t, gcpWorkerConfig)
+}
+
+// localProvider is a stand-in provider, e.g. for a local Gardener setup
+type localProvider struct{}
+
+func (localProvider) InfrastructureConfig(_ hyperscaler.ConfigOpts) ([]byte, error) {
+	return []byte(`{}`), nil
+}
+
+func (localProvider) ControlPlaneConfig(_ hyperscaler.ConfigOpts) ([]byte, error) {
+	return []byte(`{}`), nil
+}
+
+func (localProvider) WorkerConfig(_ hyperscaler.ConfigOpts) ([]byte, error) {
+	return nil, nil
+}
+
+func (localProvider) Zones(_, _ *runtime.RawExtension) ([]string, error) {
+	return nil, nil
+}
+
+func (localProvider) AddZones(infrastructureConfig *runtime.RawExtension, _ hyperscaler.ConfigOpts) (*runtime.RawExtension, error) {
+	return infrastructureConfig, nil
+}
+
+func (localProvider) ValidateZones(_ []string, _, _ *runtime.RawExtension, _ bool) error {
+	return nil
+}
+
+func (localProvider) ValidateRuntime(_ imv1.RuntimeShoot, _ *field.Path, _ bool) field.ErrorList {
+	return nil
+}
+
+func (localProvider) MaxZones() int {
+	return 0
+}
+
+func (localProvider) CloudProfileName() string {
+	return "local"
+}
+
+func (localProvider) ExposureClassName(_ hyperscaler.ConfigOpts) *string {
+	return nil
+}
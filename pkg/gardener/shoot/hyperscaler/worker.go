@@ -0,0 +1,72 @@
+package hyperscaler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MergeWorkerConfigs merges the worker providerConfigs, the fields of the later configs take precedence.
+// Nested objects are merged, other values are replaced. Empty configs are skipped, nil is returned if all configs are empty.
+func MergeWorkerConfigs(workerConfigs ...[]byte) ([]byte, error) {
+	var merged map[string]json.RawMessage
+
+	for _, workerConfig := range workerConfigs {
+		if len(bytes.TrimSpace(workerConfig)) == 0 {
+			continue
+		}
+
+		var config map[string]json.RawMessage
+		if err := json.Unmarshal(workerConfig, &config); err != nil {
+			return nil, fmt.Errorf("failed to decode worker providerConfig: %w", err)
+		}
+
+		if merged == nil {
+			merged = config
+			continue
+		}
+
+		var err error
+		if merged, err = mergeObjects(merged, config); err != nil {
+			return nil, err
+		}
+	}
+
+	if merged == nil {
+		return nil, nil
+	}
+	return json.Marshal(merged)
+}
+
+func mergeObjects(base, override map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	for key, value := range override {
+		baseObject, isBaseObject := decodeObject(base[key])
+		overrideObject, isOverrideObject := decodeObject(value)
+
+		if !isBaseObject || !isOverrideObject {
+			base[key] = value
+			continue
+		}
+
+		mergedObject, err := mergeObjects(baseObject, overrideObject)
+		if err != nil {
+			return nil, err
+		}
+		if base[key], err = json.Marshal(mergedObject); err != nil {
+			return nil, err
+		}
+	}
+	return base, nil
+}
+
+func decodeObject(value json.RawMessage) (map[string]json.RawMessage, bool) {
+	if !bytes.HasPrefix(bytes.TrimSpace(value), []byte("{")) {
+		return nil, false
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(value, &object); err != nil {
+		return nil, false
+	}
+	return object, true
+}
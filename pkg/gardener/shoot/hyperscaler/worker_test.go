@@ -0,0 +1,67 @@
+package hyperscaler_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeWorkerConfigs(t *testing.T) {
+	t.Run("Should merge nested objects, the later configs take precedence", func(t *testing.T) {
+		// given
+		template := []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"iops":3000,"throughput":125},"cpuOptions":{"coreCount":2}}`)
+		generated := []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","instanceMetadataOptions":{"httpTokens":"required","httpPutResponseHopLimit":2}}`)
+		runtimeWorkerConfig := []byte(`{"volume":{"iops":6000},"instanceMetadataOptions":{"httpPutResponseHopLimit":3},"cpuOptions":null}`)
+
+		// when
+		merged, err := hyperscaler.MergeWorkerConfigs(template, generated, runtimeWorkerConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1",
+			"kind":"WorkerConfig",
+			"volume":{"iops":6000,"throughput":125},
+			"instanceMetadataOptions":{"httpTokens":"required","httpPutResponseHopLimit":3},
+			"cpuOptions":null
+		}`, string(merged))
+	})
+
+	t.Run("Should replace lists and values of a different type", func(t *testing.T) {
+		// when
+		merged, err := hyperscaler.MergeWorkerConfigs(
+			[]byte(`{"dataVolumes":[{"name":"a"}],"serverGroup":{"policy":"soft-anti-affinity"}}`),
+			[]byte(`{"dataVolumes":[{"name":"b"}],"serverGroup":"none"}`),
+		)
+
+		// then
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"dataVolumes":[{"name":"b"}],"serverGroup":"none"}`, string(merged))
+	})
+
+	t.Run("Should skip empty configs", func(t *testing.T) {
+		// when
+		merged, err := hyperscaler.MergeWorkerConfigs(nil, []byte(`{"kind":"WorkerConfig"}`), []byte{})
+
+		// then
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"kind":"WorkerConfig"}`, string(merged))
+
+		// when
+		merged, err = hyperscaler.MergeWorkerConfigs(nil, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, merged)
+	})
+
+	t.Run("Should return error for a config which is not an object", func(t *testing.T) {
+		// when
+		_, err := hyperscaler.MergeWorkerConfigs([]byte(`{}`), []byte(`["WorkerConfig"]`))
+
+		// then
+		require.ErrorContains(t, err, "failed to decode worker providerConfig")
+	})
+}
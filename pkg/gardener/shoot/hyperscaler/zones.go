@@ -0,0 +1,62 @@
+package hyperscaler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+)
+
+// AppendZones appends the zones to networks.zones of the infrastructure config, the other fields of the config are kept unchanged
+func AppendZones[T any](infrastructureConfig []byte, zones []T) ([]byte, error) {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(infrastructureConfig, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode infrastructureConfig: %w", err)
+	}
+
+	networks := map[string]json.RawMessage{}
+	if raw, found := config["networks"]; found {
+		if err := json.Unmarshal(raw, &networks); err != nil {
+			return nil, fmt.Errorf("failed to decode infrastructureConfig networks: %w", err)
+		}
+	}
+
+	var existingZones []json.RawMessage
+	if raw, found := networks["zones"]; found {
+		if err := json.Unmarshal(raw, &existingZones); err != nil {
+			return nil, fmt.Errorf("failed to decode infrastructureConfig zones: %w", err)
+		}
+	}
+
+	for _, zone := range zones {
+		rawZone, err := json.Marshal(zone)
+		if err != nil {
+			return nil, err
+		}
+		existingZones = append(existingZones, rawZone)
+	}
+
+	var err error
+	if networks["zones"], err = json.Marshal(existingZones); err != nil {
+		return nil, err
+	}
+	if config["networks"], err = json.Marshal(networks); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(config)
+}
+
+// SubnetsFree reports whether all subnets are inside the CIDR and none of them overlaps the used subnets
+func SubnetsFree(cidr netip.Prefix, subnets, usedSubnets []netip.Prefix) bool {
+	for _, subnet := range subnets {
+		if !cidr.Contains(subnet.Addr()) || subnet.Bits() < cidr.Bits() {
+			return false
+		}
+		for _, used := range usedSubnets {
+			if subnet.Overlaps(used) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package hyperscaler_test
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendZones(t *testing.T) {
+	type zone struct {
+		Name string `json:"name"`
+		CIDR string `json:"cidr"`
+	}
+
+	t.Run("Should append the zones and keep the other fields", func(t *testing.T) {
+		// given
+		infrastructureConfig := []byte(`{"kind":"InfrastructureConfig","networks":{"vpc":{"cidr":"10.250.0.0/22"},"zones":[{"name":"a","cidr":"10.250.0.0/25","custom":true}]},"custom":{"key":"value"}}`)
+
+		// when
+		result, err := hyperscaler.AppendZones(infrastructureConfig, []zone{{Name: "b", CIDR: "10.250.0.128/25"}})
+
+		// then
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"kind":"InfrastructureConfig","networks":{"vpc":{"cidr":"10.250.0.0/22"},"zones":[{"name":"a","cidr":"10.250.0.0/25","custom":true},{"name":"b","cidr":"10.250.0.128/25"}]},"custom":{"key":"value"}}`, string(result))
+	})
+
+	t.Run("Should add the zones to the config without zones", func(t *testing.T) {
+		// when
+		result, err := hyperscaler.AppendZones([]byte(`{"kind":"InfrastructureConfig"}`), []zone{{Name: "a", CIDR: "10.250.0.0/25"}})
+
+		// then
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"kind":"InfrastructureConfig","networks":{"zones":[{"name":"a","cidr":"10.250.0.0/25"}]}}`, string(result))
+	})
+
+	t.Run("Should return error for invalid config", func(t *testing.T) {
+		// when
+		_, err := hyperscaler.AppendZones([]byte(`{"networks":[]}`), []zone{{Name: "a"}})
+
+		// then
+		require.ErrorContains(t, err, "failed to decode infrastructureConfig networks")
+	})
+}
+
+func TestSubnetsFree(t *testing.T) {
+	cidr := netip.MustParsePrefix("10.250.0.0/22")
+	used := []netip.Prefix{netip.MustParsePrefix("10.250.0.0/25")}
+
+	for tname, tcase := range map[string]struct {
+		subnets  []string
+		expected bool
+	}{
+		"Free subnet inside the CIDR":          {subnets: []string{"10.250.0.128/25", "10.250.1.0/26"}, expected: true},
+		"Subnet overlapping used subnet":       {subnets: []string{"10.250.1.0/25", "10.250.0.0/24"}, expected: false},
+		"Subnet outside the CIDR":              {subnets: []string{"10.250.4.0/25"}, expected: false},
+		"Subnet larger than the CIDR":          {subnets: []string{"10.250.0.0/21"}, expected: false},
+		"Subnet of other family than the CIDR": {subnets: []string{"fd00::/64"}, expected: false},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			var subnets []netip.Prefix
+			for _, subnet := range tcase.subnets {
+				subnets = append(subnets, netip.MustParsePrefix(subnet))
+			}
+
+			assert.Equal(t, tcase.expected, hyperscaler.SubnetsFree(cidr, subnets, used))
+		})
+	}
+}
+
+func TestAddrFromInt(t *testing.T) {
+	for tname, tcase := range map[string]struct {
+		value    *big.Int
+		bitLen   int
+		expected netip.Addr
+	}{
+		"IPv4 address":         {value: big.NewInt(0x0afa0100), bitLen: 32, expected: netip.MustParseAddr("10.250.1.0")},
+		"IPv6 address":         {value: new(big.Int).Lsh(big.NewInt(0xfd00), 112), bitLen: 128, expected: netip.MustParseAddr("fd00::")},
+		"Value exceeding IPv4": {value: new(big.Int).Lsh(big.NewInt(1), 32), bitLen: 32, expected: netip.Addr{}},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			assert.Equal(t, tcase.expected, hyperscaler.AddrFromInt(tcase.value, tcase.bitLen))
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package shoot
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
+	"k8s.io/utils/ptr"
+)
+
+// Licence type annotation set on the shoots created by the Provisioner
+const ProvisionerLicenceTypeAnnotation = "kcp.provisioner.kyma-project.io/licence-type"
+
+const (
+	shootMaintenanceTimeFormat   = "150405-0700"
+	runtimeMaintenanceTimeFormat = "150405"
+)
+
+// ToRuntime creates the Runtime spec from an existing shoot, it is the reverse of the Converter.
+// Only the fields KEB sets are taken. Labels, administrators and the platform region cannot be read from the shoot and must be set by the caller.
+// The API server ACL is taken with all its CIDRs, the KCP egress CIDRs added by the converter must be removed by the caller.
+func ToRuntime(shoot gardener.Shoot) imv1.Runtime {
+	oidcConfig := getOidcConfig(shoot)
+	kubeAPIServer := ptr.Deref(shoot.Spec.Kubernetes.KubeAPIServer, gardener.KubeAPIServerConfig{})
+
+	runtime := imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:              shoot.Name,
+				Purpose:           ptr.Deref(shoot.Spec.Purpose, ""),
+				Region:            shoot.Spec.Region,
+				LicenceType:       getLicenceType(shoot),
+				SecretBindingName: ptr.Deref(shoot.Spec.SecretBindingName, ""),
+				Kubernetes: imv1.Kubernetes{
+					Version: ptr.To(shoot.Spec.Kubernetes.Version),
+					KubeAPIServer: imv1.APIServer{
+						OidcConfig:                    oidcConfig,
+						AdditionalOidcConfig:          &[]gardener.OIDCConfig{oidcConfig},
+						AdmissionPlugins:              kubeAPIServer.AdmissionPlugins,
+						Requests:                      kubeAPIServer.Requests,
+						EventTTL:                      kubeAPIServer.EventTTL,
+						EnableAnonymousAuthentication: kubeAPIServer.EnableAnonymousAuthentication,
+					},
+					ClusterAutoscaler:     getClusterAutoscaler(shoot),
+					VerticalPodAutoscaler: getVerticalPodAutoscaler(shoot),
+					KubeProxy:             getKubeProxy(shoot),
+				},
+				Provider: imv1.Provider{
+					Type:                 shoot.Spec.Provider.Type,
+					ControlPlaneConfig:   shoot.Spec.Provider.ControlPlaneConfig,
+					InfrastructureConfig: shoot.Spec.Provider.InfrastructureConfig,
+				},
+				ControlPlane: getControlPlane(shoot),
+				Maintenance:  getMaintenance(shoot),
+				Hibernation:  shoot.Spec.Hibernation.DeepCopy(),
+			},
+			Security: imv1.Security{
+				APIServerACL: getAPIServerACL(shoot),
+				Networking: imv1.NetworkingSecurity{
+					Filter: imv1.Filter{
+						Egress: imv1.Egress{
+							Enabled: isNetworkFilterEnabled(shoot),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// the first worker is the main one, the converter requires exactly one main worker
+	workers := FilterOutFields(shoot.Spec.Provider.Workers)
+	if len(workers) > 0 {
+		runtime.Spec.Shoot.Provider.Workers = workers[:1]
+	}
+	if len(workers) > 1 {
+		runtime.Spec.Shoot.Provider.AdditionalWorkers = ptr.To(workers[1:])
+	}
+
+	if shoot.Spec.Networking != nil {
+		runtime.Spec.Shoot.Networking = imv1.Networking{
+			Type:       shoot.Spec.Networking.Type,
+			Pods:       ptr.Deref(shoot.Spec.Networking.Pods, ""),
+			Nodes:      ptr.Deref(shoot.Spec.Networking.Nodes, ""),
+			Services:   ptr.Deref(shoot.Spec.Networking.Services, ""),
+			IPFamilies: shoot.Spec.Networking.IPFamilies,
+		}
+	}
+
+	return runtime
+}
+
+// FilterOutFields creates a new slice with workers containing only the fields KEB sets
+func FilterOutFields(workers []gardener.Worker) []gardener.Worker {
+	newWorkers := make([]gardener.Worker, 0)
+
+	for _, worker := range workers {
+		newWorker := gardener.Worker{
+			Machine:        worker.Machine,
+			Maximum:        worker.Maximum,
+			Minimum:        worker.Minimum,
+			MaxSurge:       worker.MaxSurge,
+			MaxUnavailable: worker.MaxUnavailable,
+			Name:           worker.Name,
+			Volume:         worker.Volume,
+			Zones:          worker.Zones,
+		}
+
+		newWorkers = append(newWorkers, newWorker)
+	}
+
+	return newWorkers
+}
+
+func getOidcConfig(shoot gardener.Shoot) gardener.OIDCConfig {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil || shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig == nil {
+		return gardener.OIDCConfig{}
+	}
+
+	oidcConfig := shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig
+
+	return gardener.OIDCConfig{
+		CABundle:             nil, // deliberately left empty
+		ClientAuthentication: oidcConfig.ClientAuthentication,
+		ClientID:             oidcConfig.ClientID,
+		GroupsClaim:          oidcConfig.GroupsClaim,
+		GroupsPrefix:         oidcConfig.GroupsPrefix,
+		IssuerURL:            oidcConfig.IssuerURL,
+		RequiredClaims:       oidcConfig.RequiredClaims,
+		SigningAlgs:          oidcConfig.SigningAlgs,
+		UsernameClaim:        oidcConfig.UsernameClaim,
+		UsernamePrefix:       oidcConfig.UsernamePrefix,
+	}
+}
+
+func getLicenceType(shoot gardener.Shoot) *string {
+	for _, annotation := range []string{extender.ShootLicenceTypeAnnotation, ProvisionerLicenceTypeAnnotation} {
+		if licenceType, found := shoot.Annotations[annotation]; found && licenceType != "" {
+			return ptr.To(licenceType)
+		}
+	}
+	return nil
+}
+
+func getControlPlane(shoot gardener.Shoot) *gardener.ControlPlane {
+	if shoot.Spec.ControlPlane == nil || shoot.Spec.ControlPlane.HighAvailability == nil {
+		return nil
+	}
+
+	return &gardener.ControlPlane{
+		HighAvailability: &gardener.HighAvailability{
+			FailureTolerance: gardener.FailureTolerance{
+				Type: shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type,
+			},
+		},
+	}
+}
+
+func getClusterAutoscaler(shoot gardener.Shoot) *imv1.ClusterAutoscaler {
+	clusterAutoscaler := shoot.Spec.Kubernetes.ClusterAutoscaler
+	if clusterAutoscaler == nil {
+		return nil
+	}
+
+	return &imv1.ClusterAutoscaler{
+		Expander:                      clusterAutoscaler.Expander,
+		ScaleDownDelayAfterAdd:        clusterAutoscaler.ScaleDownDelayAfterAdd,
+		ScaleDownDelayAfterDelete:     clusterAutoscaler.ScaleDownDelayAfterDelete,
+		ScaleDownDelayAfterFailure:    clusterAutoscaler.ScaleDownDelayAfterFailure,
+		ScaleDownUnneededTime:         clusterAutoscaler.ScaleDownUnneededTime,
+		ScaleDownUtilizationThreshold: clusterAutoscaler.ScaleDownUtilizationThreshold,
+		MaxNodeProvisionTime:          clusterAutoscaler.MaxNodeProvisionTime,
+	}
+}
+
+func getVerticalPodAutoscaler(shoot gardener.Shoot) *imv1.VerticalPodAutoscaler {
+	if shoot.Spec.Kubernetes.VerticalPodAutoscaler == nil {
+		return nil
+	}
+
+	return &imv1.VerticalPodAutoscaler{
+		Enabled: ptr.To(shoot.Spec.Kubernetes.VerticalPodAutoscaler.Enabled),
+	}
+}
+
+// the kube-proxy is set only with its mode, the other fields are not taken
+func getKubeProxy(shoot gardener.Shoot) *imv1.KubeProxy {
+	if shoot.Spec.Kubernetes.KubeProxy == nil || shoot.Spec.Kubernetes.KubeProxy.Mode == nil {
+		return nil
+	}
+
+	return &imv1.KubeProxy{
+		Mode: shoot.Spec.Kubernetes.KubeProxy.Mode,
+	}
+}
+
+func isNetworkFilterEnabled(shoot gardener.Shoot) bool {
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type == extensions.NetworkFilterType {
+			return !ptr.Deref(extension.Disabled, false)
+		}
+	}
+	return false
+}
+
+func getAPIServerACL(shoot gardener.Shoot) *imv1.APIServerACL {
+	rule := getACLRule(shoot)
+	if rule == nil || len(rule.Cidrs) == 0 {
+		return nil
+	}
+
+	return &imv1.APIServerACL{
+		AllowedCIDRs: rule.Cidrs,
+	}
+}
+
+// the rule of a disabled ACL extension is not taken, the rule of an invalid providerConfig is empty
+func getACLRule(shoot gardener.Shoot) *extensions.ACLRule {
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type != extensions.ACLExtensionType {
+			continue
+		}
+		if ptr.Deref(extension.Disabled, false) {
+			return nil
+		}
+
+		var aclConfig extensions.ACLExtensionConfig
+		if extension.ProviderConfig != nil {
+			if err := json.Unmarshal(extension.ProviderConfig.Raw, &aclConfig); err != nil {
+				return &extensions.ACLRule{}
+			}
+		}
+		return &aclConfig.Rule
+	}
+	return nil
+}
+
+func getMaintenance(shoot gardener.Shoot) *imv1.Maintenance {
+	if shoot.Spec.Maintenance == nil {
+		return nil
+	}
+
+	maintenance := &imv1.Maintenance{}
+	if autoUpdate := shoot.Spec.Maintenance.AutoUpdate; autoUpdate != nil {
+		maintenance.AutoUpdate = &imv1.MaintenanceAutoUpdate{
+			KubernetesVersion:   ptr.To(autoUpdate.KubernetesVersion),
+			MachineImageVersion: autoUpdate.MachineImageVersion,
+		}
+	}
+	if timeWindow := shoot.Spec.Maintenance.TimeWindow; timeWindow != nil {
+		maintenance.TimeWindow, maintenance.Timezone = getMaintenanceTimeWindow(*timeWindow)
+	}
+
+	return maintenance
+}
+
+// the time window is taken in the fixed offset timezone of the shoot, so the converter sets the same time window again.
+// The offsets not in full hours have no such timezone, the time window is taken in UTC then.
+func getMaintenanceTimeWindow(timeWindow gardener.MaintenanceTimeWindow) (*imv1.MaintenanceTimeWindow, *string) {
+	begin, beginErr := time.Parse(shootMaintenanceTimeFormat, timeWindow.Begin)
+	end, endErr := time.Parse(shootMaintenanceTimeFormat, timeWindow.End)
+	if beginErr != nil || endErr != nil {
+		return nil, nil
+	}
+
+	_, beginOffset := begin.Zone()
+	_, endOffset := end.Zone()
+	if beginOffset != endOffset || beginOffset%int(time.Hour.Seconds()) != 0 {
+		begin, end = begin.UTC(), end.UTC()
+		beginOffset = 0
+	}
+
+	return &imv1.MaintenanceTimeWindow{
+		Begin: begin.Format(runtimeMaintenanceTimeFormat),
+		End:   end.Format(runtimeMaintenanceTimeFormat),
+	}, ptr.To(fixedOffsetTimezone(beginOffset / int(time.Hour.Seconds())))
+}
+
+// the sign of the Etc/GMT timezones is inverted, Etc/GMT-1 is one hour ahead of UTC
+func fixedOffsetTimezone(offsetHours int) string {
+	if offsetHours == 0 {
+		return "UTC"
+	}
+	return fmt.Sprintf("Etc/GMT%+d", -offsetHours)
+}
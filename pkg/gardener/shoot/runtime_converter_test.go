@@ -0,0 +1,570 @@
+package shoot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestToRuntime(t *testing.T) {
+	t.Run("Should create Runtime spec from shoot", func(t *testing.T) {
+		// given
+		shoot := fixShootToAdopt()
+
+		// when
+		runtime := ToRuntime(shoot)
+
+		// then
+		runtimeShoot := runtime.Spec.Shoot
+		assert.Equal(t, "test-shoot", runtimeShoot.Name)
+		assert.Equal(t, gardener.ShootPurposeProduction, runtimeShoot.Purpose)
+		assert.Equal(t, "eu-central-1", runtimeShoot.Region)
+		assert.Equal(t, "my-secret", runtimeShoot.SecretBindingName)
+		assert.Equal(t, ptr.To("TestDevelopmentAndDemo"), runtimeShoot.LicenceType)
+		assert.Equal(t, ptr.To("1.30"), runtimeShoot.Kubernetes.Version)
+		assert.Equal(t, ptr.To("client-id"), runtimeShoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID)
+		assert.Nil(t, runtimeShoot.Kubernetes.KubeAPIServer.OidcConfig.CABundle)
+		assert.Equal(t, []gardener.OIDCConfig{runtimeShoot.Kubernetes.KubeAPIServer.OidcConfig}, *runtimeShoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig)
+		assert.Equal(t, "aws", runtimeShoot.Provider.Type)
+		assert.Equal(t, shoot.Spec.Provider.InfrastructureConfig, runtimeShoot.Provider.InfrastructureConfig)
+		assert.Equal(t, shoot.Spec.Provider.ControlPlaneConfig, runtimeShoot.Provider.ControlPlaneConfig)
+		assert.Equal(t, "10.250.0.0/16", runtimeShoot.Networking.Nodes)
+		assert.Equal(t, "100.64.0.0/12", runtimeShoot.Networking.Pods)
+		assert.Equal(t, "100.104.0.0/13", runtimeShoot.Networking.Services)
+		assert.Equal(t, []gardener.IPFamily{gardener.IPFamilyIPv4}, runtimeShoot.Networking.IPFamilies)
+		assert.Equal(t, gardener.FailureToleranceTypeZone, runtimeShoot.ControlPlane.HighAvailability.FailureTolerance.Type)
+		assert.True(t, runtime.Spec.Security.Networking.Filter.Egress.Enabled)
+		assert.Equal(t, shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins, runtimeShoot.Kubernetes.KubeAPIServer.AdmissionPlugins)
+		assert.Equal(t, &gardener.APIServerRequests{MaxMutatingInflight: ptr.To(int32(400))}, runtimeShoot.Kubernetes.KubeAPIServer.Requests)
+		assert.Equal(t, &v1.Duration{Duration: 2 * time.Hour}, runtimeShoot.Kubernetes.KubeAPIServer.EventTTL)
+		assert.Equal(t, ptr.To(false), runtimeShoot.Kubernetes.KubeAPIServer.EnableAnonymousAuthentication)
+		assert.Equal(t, &imv1.ClusterAutoscaler{
+			Expander:                      ptr.To(gardener.ClusterAutoscalerExpanderLeastWaste),
+			ScaleDownUnneededTime:         &v1.Duration{Duration: 15 * time.Minute},
+			ScaleDownUtilizationThreshold: ptr.To(0.6),
+		}, runtimeShoot.Kubernetes.ClusterAutoscaler)
+		assert.Equal(t, &imv1.VerticalPodAutoscaler{Enabled: ptr.To(true)}, runtimeShoot.Kubernetes.VerticalPodAutoscaler)
+		assert.Equal(t, &imv1.KubeProxy{Mode: ptr.To(gardener.ProxyModeIPVS)}, runtimeShoot.Kubernetes.KubeProxy)
+		assert.Equal(t, shoot.Spec.Hibernation, runtimeShoot.Hibernation)
+		assert.Equal(t, &imv1.Maintenance{
+			TimeWindow: &imv1.MaintenanceTimeWindow{Begin: "220000", End: "230000"},
+			Timezone:   ptr.To("Etc/GMT-1"),
+			AutoUpdate: &imv1.MaintenanceAutoUpdate{KubernetesVersion: ptr.To(false), MachineImageVersion: ptr.To(true)},
+		}, runtimeShoot.Maintenance)
+		assert.Equal(t, &imv1.APIServerACL{AllowedCIDRs: []string{"10.0.0.0/8", "192.168.0.0/24"}}, runtime.Spec.Security.APIServerACL)
+
+		require.Len(t, runtimeShoot.Provider.Workers, 1)
+		assert.Equal(t, "worker", runtimeShoot.Provider.Workers[0].Name)
+		assert.Nil(t, runtimeShoot.Provider.Workers[0].CRI)
+		require.NotNil(t, runtimeShoot.Provider.AdditionalWorkers)
+		require.Len(t, *runtimeShoot.Provider.AdditionalWorkers, 1)
+		assert.Equal(t, "additional", (*runtimeShoot.Provider.AdditionalWorkers)[0].Name)
+	})
+
+	t.Run("Should read licence type from the Provisioner annotation", func(t *testing.T) {
+		// given
+		shoot := fixShootToAdopt()
+		shoot.Annotations = map[string]string{ProvisionerLicenceTypeAnnotation: "Partner"}
+
+		// when
+		runtime := ToRuntime(shoot)
+
+		// then
+		assert.Equal(t, ptr.To("Partner"), runtime.Spec.Shoot.LicenceType)
+	})
+
+	t.Run("Should create Runtime spec from shoot with no optional fields", func(t *testing.T) {
+		// when
+		runtime := ToRuntime(gardener.Shoot{})
+
+		// then
+		assert.Nil(t, runtime.Spec.Shoot.LicenceType)
+		assert.Nil(t, runtime.Spec.Shoot.ControlPlane)
+		assert.Nil(t, runtime.Spec.Shoot.Provider.AdditionalWorkers)
+		assert.Empty(t, runtime.Spec.Shoot.Provider.Workers)
+		assert.False(t, runtime.Spec.Security.Networking.Filter.Egress.Enabled)
+		assert.Equal(t, imv1.APIServer{AdditionalOidcConfig: &[]gardener.OIDCConfig{{}}}, runtime.Spec.Shoot.Kubernetes.KubeAPIServer)
+		assert.Nil(t, runtime.Spec.Shoot.Kubernetes.ClusterAutoscaler)
+		assert.Nil(t, runtime.Spec.Shoot.Kubernetes.VerticalPodAutoscaler)
+		assert.Nil(t, runtime.Spec.Shoot.Kubernetes.KubeProxy)
+		assert.Nil(t, runtime.Spec.Security.APIServerACL)
+		assert.Nil(t, runtime.Spec.Shoot.Hibernation)
+		assert.Nil(t, runtime.Spec.Shoot.Maintenance)
+	})
+
+	t.Run("Should read maintenance time window with offset not in full hours in UTC", func(t *testing.T) {
+		// given
+		shoot := fixShootToAdopt()
+		shoot.Spec.Maintenance.TimeWindow = &gardener.MaintenanceTimeWindow{Begin: "220000+0530", End: "230000+0530"}
+
+		// when
+		runtime := ToRuntime(shoot)
+
+		// then
+		assert.Equal(t, &imv1.MaintenanceTimeWindow{Begin: "163000", End: "173000"}, runtime.Spec.Shoot.Maintenance.TimeWindow)
+		assert.Equal(t, ptr.To("UTC"), runtime.Spec.Shoot.Maintenance.Timezone)
+	})
+
+	t.Run("Should not read disabled API server ACL", func(t *testing.T) {
+		// given
+		shoot := fixShootToAdopt()
+		shoot.Spec.Extensions = []gardener.Extension{{Type: extensions.ACLExtensionType, Disabled: ptr.To(true)}}
+
+		// when
+		runtime := ToRuntime(shoot)
+
+		// then
+		assert.Nil(t, runtime.Spec.Security.APIServerACL)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("Should pass for equal shoots", func(t *testing.T) {
+		// given
+		original := fixShootToAdopt()
+		converted := fixShootToAdopt()
+		converted.Spec.Provider.Workers[0].CRI = nil
+		var indented bytes.Buffer
+		require.NoError(t, json.Indent(&indented, original.Spec.Provider.InfrastructureConfig.Raw, "", "  "))
+		converted.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: indented.Bytes()}
+
+		// when
+		err := Verify(original, converted)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should pass for ACL CIDRs in different order", func(t *testing.T) {
+		// given
+		original := fixShootToAdopt()
+		converted := fixShootToAdopt()
+		converted.Spec.Extensions[1] = fixACLExtension("192.168.0.0/24", "10.0.0.0/8")
+
+		// when
+		err := Verify(original, converted)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should pass for the same maintenance time window with another offset", func(t *testing.T) {
+		// given
+		original := fixShootToAdopt()
+		converted := fixShootToAdopt()
+		converted.Spec.Maintenance.TimeWindow = &gardener.MaintenanceTimeWindow{Begin: "210000+0000", End: "220000+0000"}
+
+		// when
+		err := Verify(original, converted)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should list differences", func(t *testing.T) {
+		// given
+		original := fixShootToAdopt()
+		converted := fixShootToAdopt()
+		converted.Spec.Kubernetes.Version = "1.31"
+		converted.Spec.Provider.Workers[0].Maximum = 10
+		converted.Spec.Kubernetes.ClusterAutoscaler.Expander = ptr.To(gardener.ClusterAutoscalerExpanderRandom)
+		converted.Spec.Kubernetes.VerticalPodAutoscaler = nil
+		converted.Spec.Kubernetes.KubeProxy.Mode = ptr.To(gardener.ProxyModeIPTables)
+		converted.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = converted.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins[:1]
+		converted.Spec.Kubernetes.KubeAPIServer.Requests = nil
+		converted.Spec.Kubernetes.KubeAPIServer.EventTTL = &v1.Duration{Duration: time.Hour}
+		converted.Spec.Kubernetes.KubeAPIServer.EnableAnonymousAuthentication = nil
+		converted.Spec.Extensions = converted.Spec.Extensions[:1]
+		converted.Spec.Hibernation.Enabled = ptr.To(true)
+		converted.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+		converted.Spec.Maintenance.TimeWindow.Begin = "210000+0100"
+
+		// when
+		err := Verify(original, converted)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "spec/kubernetes/version")
+		assert.Contains(t, err.Error(), "spec/kubernetes/kubeAPIServer/admissionPlugins")
+		assert.Contains(t, err.Error(), "spec/kubernetes/kubeAPIServer/requests")
+		assert.Contains(t, err.Error(), "spec/kubernetes/kubeAPIServer/eventTTL")
+		assert.Contains(t, err.Error(), "spec/kubernetes/kubeAPIServer/enableAnonymousAuthentication")
+		assert.Contains(t, err.Error(), "spec/kubernetes/clusterAutoscaler")
+		assert.Contains(t, err.Error(), "spec/kubernetes/verticalPodAutoscaler")
+		assert.Contains(t, err.Error(), "spec/kubernetes/kubeProxy")
+		assert.Contains(t, err.Error(), "spec/provider/workers")
+		assert.Contains(t, err.Error(), "spec/extensions/acl")
+		assert.Contains(t, err.Error(), "spec/hibernation")
+		assert.Contains(t, err.Error(), "spec/maintenance/autoUpdate")
+		assert.Contains(t, err.Error(), "spec/maintenance/timeWindow")
+		assert.NotContains(t, err.Error(), "spec/networking")
+	})
+}
+
+func fixShootToAdopt() gardener.Shoot {
+	return gardener.Shoot{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-shoot",
+			Namespace: "garden-test",
+			Annotations: map[string]string{
+				extender.ShootLicenceTypeAnnotation: "TestDevelopmentAndDemo",
+			},
+		},
+		Spec: gardener.ShootSpec{
+			Purpose:           ptr.To(gardener.ShootPurposeProduction),
+			Region:            "eu-central-1",
+			SecretBindingName: ptr.To("my-secret"),
+			Kubernetes: gardener.Kubernetes{
+				Version: "1.30",
+				ClusterAutoscaler: &gardener.ClusterAutoscaler{
+					Expander:                      ptr.To(gardener.ClusterAutoscalerExpanderLeastWaste),
+					ScaleDownUnneededTime:         &v1.Duration{Duration: 15 * time.Minute},
+					ScaleDownUtilizationThreshold: ptr.To(0.6),
+					IgnoreTaints:                  []string{"example.com/taint"},
+				},
+				VerticalPodAutoscaler: &gardener.VerticalPodAutoscaler{
+					Enabled:                true,
+					EvictAfterOOMThreshold: &v1.Duration{Duration: 10 * time.Minute},
+				},
+				KubeProxy: &gardener.KubeProxyConfig{
+					Mode:    ptr.To(gardener.ProxyModeIPVS),
+					Enabled: ptr.To(true),
+				},
+				KubeAPIServer: &gardener.KubeAPIServerConfig{
+					AdmissionPlugins: []gardener.AdmissionPlugin{
+						{Name: "PodNodeSelector", Config: &runtime.RawExtension{Raw: []byte(`{"podNodeSelectorPluginConfig":{"clusterDefaultNodeSelector":"env=dev"}}`)}},
+						{Name: "AlwaysPullImages", Disabled: ptr.To(true)},
+					},
+					Requests:                      &gardener.APIServerRequests{MaxMutatingInflight: ptr.To(int32(400))},
+					EventTTL:                      &v1.Duration{Duration: 2 * time.Hour},
+					EnableAnonymousAuthentication: ptr.To(false),
+					OIDCConfig: &gardener.OIDCConfig{
+						CABundle:      ptr.To("ca-bundle"),
+						ClientID:      ptr.To("client-id"),
+						GroupsClaim:   ptr.To("groups"),
+						IssuerURL:     ptr.To("https://my.cool.tokens.com"),
+						SigningAlgs:   []string{"RS256"},
+						UsernameClaim: ptr.To("sub"),
+					},
+				},
+			},
+			Networking: &gardener.Networking{
+				Type:       ptr.To("calico"),
+				Nodes:      ptr.To("10.250.0.0/16"),
+				Pods:       ptr.To("100.64.0.0/12"),
+				Services:   ptr.To("100.104.0.0/13"),
+				IPFamilies: []gardener.IPFamily{gardener.IPFamilyIPv4},
+			},
+			ControlPlane: &gardener.ControlPlane{
+				HighAvailability: &gardener.HighAvailability{
+					FailureTolerance: gardener.FailureTolerance{
+						Type: gardener.FailureToleranceTypeZone,
+					},
+				},
+			},
+			Maintenance: &gardener.Maintenance{
+				AutoUpdate: &gardener.MaintenanceAutoUpdate{
+					KubernetesVersion:   false,
+					MachineImageVersion: ptr.To(true),
+				},
+				TimeWindow: &gardener.MaintenanceTimeWindow{
+					Begin: "220000+0100",
+					End:   "230000+0100",
+				},
+			},
+			Hibernation: &gardener.Hibernation{
+				Enabled: ptr.To(false),
+				Schedules: []gardener.HibernationSchedule{
+					{Start: ptr.To("00 20 * * 1,2,3,4,5"), End: ptr.To("00 08 * * 1,2,3,4,5"), Location: ptr.To("Europe/Berlin")},
+				},
+			},
+			Provider: gardener.Provider{
+				Type:                 "aws",
+				InfrastructureConfig: fixAWSInfrastructureConfig("10.250.0.0/16", []string{"eu-central-1a"}),
+				ControlPlaneConfig:   fixAWSControlPlaneConfig(),
+				Workers: []gardener.Worker{
+					{
+						Name:    "worker",
+						Machine: gardener.Machine{Type: "m6i.large", Image: &gardener.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1592.1.0")}},
+						Minimum: 1,
+						Maximum: 3,
+						Zones:   []string{"eu-central-1a"},
+						CRI:     &gardener.CRI{Name: gardener.CRINameContainerD},
+					},
+					{
+						Name:    "additional",
+						Machine: gardener.Machine{Type: "m6i.xlarge", Image: &gardener.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1592.1.0")}},
+						Minimum: 0,
+						Maximum: 1,
+						Zones:   []string{"eu-central-1a"},
+					},
+				},
+			},
+			Extensions: []gardener.Extension{
+				{
+					Type:     extensions.NetworkFilterType,
+					Disabled: ptr.To(false),
+				},
+				fixACLExtension("10.0.0.0/8", "192.168.0.0/24"),
+			},
+		},
+	}
+}
+
+func fixACLExtension(cidrs ...string) gardener.Extension {
+	providerConfig, _ := json.Marshal(extensions.ACLExtensionConfig{
+		Rule: extensions.ACLRule{Action: "ALLOW", Type: "remote_ip", Cidrs: cidrs},
+	})
+	return gardener.Extension{
+		Type:           extensions.ACLExtensionType,
+		ProviderConfig: &runtime.RawExtension{Raw: providerConfig},
+		Disabled:       ptr.To(false),
+	}
+}
+
+func TestToRuntimeRoundTrip(t *testing.T) {
+	for _, testCase := range []struct {
+		name    string
+		runtime func() imv1.Runtime
+	}{
+		{
+			name:    "single worker",
+			runtime: fixRuntime,
+		},
+		{
+			name: "no high availability control plane",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				runtime.Spec.Shoot.ControlPlane = nil
+				return runtime
+			},
+		},
+		{
+			name: "additional workers",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				additional := runtime.Spec.Shoot.Provider.Workers[0]
+				additional.Name = "additional"
+				additional.Machine.Type = "m6i.xlarge"
+				additional.Zones = []string{"eu-central-1a"}
+				runtime.Spec.Shoot.Provider.AdditionalWorkers = &[]gardener.Worker{additional}
+				return runtime
+			},
+		},
+		{
+			name: "kubernetes components",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				runtime.Spec.Shoot.Kubernetes.ClusterAutoscaler = &imv1.ClusterAutoscaler{
+					Expander:             ptr.To(gardener.ClusterAutoscalerExpanderMostPods),
+					MaxNodeProvisionTime: &v1.Duration{Duration: 20 * time.Minute},
+				}
+				runtime.Spec.Shoot.Kubernetes.VerticalPodAutoscaler = &imv1.VerticalPodAutoscaler{Enabled: ptr.To(false)}
+				runtime.Spec.Shoot.Kubernetes.KubeProxy = &imv1.KubeProxy{Mode: ptr.To(gardener.ProxyModeIPTables)}
+				return runtime
+			},
+		},
+		{
+			name: "API server settings",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				apiServer := &runtime.Spec.Shoot.Kubernetes.KubeAPIServer
+				apiServer.AdmissionPlugins = []gardener.AdmissionPlugin{{Name: "PodNodeSelector", Disabled: ptr.To(true)}}
+				apiServer.Requests = &gardener.APIServerRequests{MaxNonMutatingInflight: ptr.To(int32(800))}
+				apiServer.EventTTL = &v1.Duration{Duration: 30 * time.Minute}
+				apiServer.EnableAnonymousAuthentication = ptr.To(true)
+				return runtime
+			},
+		},
+		{
+			name: "API server ACL",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				runtime.Spec.Security.APIServerACL = &imv1.APIServerACL{AllowedCIDRs: []string{"10.0.0.0/8"}}
+				return runtime
+			},
+		},
+		{
+			name: "hibernation",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				runtime.Spec.Shoot.Hibernation = &gardener.Hibernation{
+					Enabled:   ptr.To(true),
+					Schedules: []gardener.HibernationSchedule{{Start: ptr.To("00 20 * * *"), Location: ptr.To("UTC")}},
+				}
+				return runtime
+			},
+		},
+		{
+			name: "maintenance",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				runtime.Spec.Shoot.Maintenance = &imv1.Maintenance{
+					TimeWindow: &imv1.MaintenanceTimeWindow{Begin: "010000", End: "020000"},
+					Timezone:   ptr.To("Asia/Kolkata"),
+					AutoUpdate: &imv1.MaintenanceAutoUpdate{KubernetesVersion: ptr.To(false)},
+				}
+				return runtime
+			},
+		},
+		{
+			name: "network filter enabled",
+			runtime: func() imv1.Runtime {
+				runtime := fixRuntime()
+				runtime.Spec.Security.Networking.Filter.Egress.Enabled = true
+				return runtime
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			original := testCase.runtime()
+			original.Spec.Shoot.Name = "test-shoot"
+			original.Spec.Shoot.LicenceType = ptr.To("TestDevelopmentAndDemo")
+
+			shoot, err := NewConverterCreate(CreateOpts{ConverterConfig: fixConverterConfig()}).ToShoot(original)
+			require.NoError(t, err)
+
+			// when
+			runtime := ToRuntime(shoot)
+			converted, err := convertPatchForRoundTrip(shoot, runtime)
+
+			// then
+			require.NoError(t, err)
+			require.NoError(t, Verify(shoot, converted))
+			assert.Equal(t, original.Spec.Shoot.LicenceType, runtime.Spec.Shoot.LicenceType)
+			assert.Equal(t, original.Spec.Security.Networking.Filter.Egress.Enabled, runtime.Spec.Security.Networking.Filter.Egress.Enabled)
+			assert.Equal(t, original.Spec.Shoot.Hibernation, runtime.Spec.Shoot.Hibernation)
+			if original.Spec.Security.APIServerACL != nil {
+				assert.Equal(t, append(original.Spec.Security.APIServerACL.AllowedCIDRs, fixConverterConfig().APIServerACL.KCPEgressCIDRs...), runtime.Spec.Security.APIServerACL.AllowedCIDRs)
+			}
+		})
+	}
+}
+
+func TestToRuntimeRoundTripForExistingShoot(t *testing.T) {
+	// given
+	shoot := fixShootToAdopt()
+
+	// when
+	converted, err := NewConverterPatch(PatchOpts{
+		ConverterConfig:      fixConverterConfig(),
+		ShootK8SVersion:      shoot.Spec.Kubernetes.Version,
+		Workers:              shoot.Spec.Provider.Workers,
+		Extensions:           shoot.Spec.Extensions,
+		InfrastructureConfig: shoot.Spec.Provider.InfrastructureConfig,
+		ControlPlaneConfig:   shoot.Spec.Provider.ControlPlaneConfig,
+	}).ToShoot(ToRuntime(shoot))
+
+	// then
+	require.NoError(t, err)
+	require.NoError(t, Verify(shoot, converted))
+}
+
+// TestToRuntimeRandomizedRoundTrip converts random Runtimes of all providers to shoots and back.
+// The seed is fixed, so a failing case can be reproduced by its name.
+func TestToRuntimeRandomizedRoundTrip(t *testing.T) {
+	providers := []struct {
+		providerType string
+		region       string
+		zones        []string
+		machineTypes []string
+	}{
+		{hyperscaler.TypeAWS, "eu-central-1", []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}, []string{"m6i.large", "m6i.xlarge", "g6.xlarge"}},
+		{hyperscaler.TypeAzure, "westeurope", []string{"1", "2", "3"}, []string{"Standard_D4s_v5", "Standard_D8s_v5"}},
+		{hyperscaler.TypeGCP, "europe-west3", []string{"europe-west3-a", "europe-west3-b", "europe-west3-c"}, []string{"n2-standard-4", "n2-standard-8"}},
+		{hyperscaler.TypeOpenStack, "eu-de-1", []string{"eu-de-1a", "eu-de-1b", "eu-de-1d"}, []string{"g_c4_m16", "g_c8_m32"}},
+	}
+
+	random := rand.New(rand.NewSource(1)) //nolint:gosec
+
+	for i := 0; i < 100; i++ {
+		provider := providers[random.Intn(len(providers))]
+
+		randomWorker := func(name string) gardener.Worker {
+			zones := slices.Clone(provider.zones)
+			random.Shuffle(len(zones), func(a, b int) { zones[a], zones[b] = zones[b], zones[a] })
+			minimum := int32(random.Intn(3))
+
+			return gardener.Worker{
+				Name: name,
+				Machine: gardener.Machine{
+					Type:  provider.machineTypes[random.Intn(len(provider.machineTypes))],
+					Image: &gardener.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1592.1.0")},
+				},
+				Minimum: minimum,
+				Maximum: minimum + int32(random.Intn(5)) + 1,
+				Zones:   zones[:random.Intn(len(zones))+1],
+			}
+		}
+
+		original := fixRuntime()
+		original.Spec.Shoot.Name = fmt.Sprintf("shoot-%d", i)
+		original.Spec.Shoot.Region = provider.region
+		original.Spec.Shoot.Provider.Type = provider.providerType
+		original.Spec.Shoot.Provider.Workers = []gardener.Worker{randomWorker("worker")}
+		if additionalWorkers := random.Intn(4); additionalWorkers > 0 {
+			workers := make([]gardener.Worker, 0, additionalWorkers)
+			for j := 0; j < additionalWorkers; j++ {
+				workers = append(workers, randomWorker(fmt.Sprintf("additional-%d", j)))
+			}
+			original.Spec.Shoot.Provider.AdditionalWorkers = &workers
+		}
+		if random.Intn(2) == 0 {
+			original.Spec.Shoot.ControlPlane = nil
+		}
+		original.Spec.Security.Networking.Filter.Egress.Enabled = random.Intn(2) == 0
+		if random.Intn(2) == 0 {
+			original.Spec.Shoot.Kubernetes.ClusterAutoscaler = &imv1.ClusterAutoscaler{
+				ScaleDownUnneededTime: &v1.Duration{Duration: time.Duration(random.Intn(30)+1) * time.Minute},
+			}
+			original.Spec.Shoot.Kubernetes.KubeProxy = &imv1.KubeProxy{Mode: ptr.To(gardener.ProxyModeIPVS)}
+		}
+
+		t.Run(fmt.Sprintf("%s %s", provider.providerType, original.Spec.Shoot.Name), func(t *testing.T) {
+			// given
+			shoot, err := NewConverterCreate(CreateOpts{ConverterConfig: fixConverterConfig()}).ToShoot(original)
+			require.NoError(t, err)
+
+			// when
+			runtime := ToRuntime(shoot)
+			converted, err := convertPatchForRoundTrip(shoot, runtime)
+			require.NoError(t, err)
+
+			reconverted, err := convertPatchForRoundTrip(converted, ToRuntime(converted))
+
+			// then
+			require.NoError(t, err)
+			require.NoError(t, Verify(shoot, converted))
+			require.NoError(t, Verify(shoot, reconverted))
+			assert.Equal(t, ToRuntime(shoot).Spec, ToRuntime(converted).Spec)
+		})
+	}
+}
+
+func convertPatchForRoundTrip(shoot gardener.Shoot, runtime imv1.Runtime) (gardener.Shoot, error) {
+	return NewConverterPatch(PatchOpts{
+		ConverterConfig:      fixConverterConfig(),
+		ShootK8SVersion:      shoot.Spec.Kubernetes.Version,
+		Workers:              shoot.Spec.Provider.Workers,
+		Extensions:           shoot.Spec.Extensions,
+		Resources:            shoot.Spec.Resources,
+		InfrastructureConfig: shoot.Spec.Provider.InfrastructureConfig,
+		ControlPlaneConfig:   shoot.Spec.Provider.ControlPlaneConfig,
+	}).ToShoot(runtime)
+}
@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateNetworking checks the IP families, the pods, nodes and services CIDRs and the nodes CIDR capacity of the Runtime shoot networking.
+// The CIDRs must be valid network addresses of the primary (first) IP family and must not overlap, empty CIDRs are skipped.
+func ValidateNetworking(shoot imv1.RuntimeShoot, fldPath *field.Path) field.ErrorList {
+	networking := shoot.Networking
+	networkingPath := fldPath.Child("networking")
+	allErrs := validateIPFamilies(networking.IPFamilies, networkingPath.Child("ipFamilies"))
+
+	primaryIPFamily := gardener.IPFamilyIPv4
+	if len(networking.IPFamilies) > 0 {
+		primaryIPFamily = networking.IPFamilies[0]
+	}
+
+	type namedCIDR struct {
+		name   string
+		prefix netip.Prefix
+	}
+	var cidrs []namedCIDR
+
+	for _, cidrField := range []struct{ name, cidr string }{
+		{"pods", networking.Pods},
+		{"nodes", networking.Nodes},
+		{"services", networking.Services},
+	} {
+		if cidrField.cidr == "" {
+			continue
+		}
+
+		prefix, err := parseCIDR(cidrField.cidr, primaryIPFamily)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(networkingPath.Child(cidrField.name), cidrField.cidr, err.Error()))
+			continue
+		}
+
+		for _, other := range cidrs {
+			if other.prefix.Overlaps(prefix) {
+				allErrs = append(allErrs, field.Invalid(networkingPath.Child(cidrField.name), cidrField.cidr, fmt.Sprintf("overlaps with the %s CIDR %s", other.name, other.prefix)))
+			}
+		}
+		cidrs = append(cidrs, namedCIDR{name: cidrField.name, prefix: prefix})
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	return validateNodesCapacity(shoot, fldPath)
+}
+
+// validateNodesCapacity checks if the zone subnets can be carved from the nodes CIDR.
+// The check is skipped for providers not carving zone subnets and when the infrastructureConfig is specified in the Runtime.
+func validateNodesCapacity(shoot imv1.RuntimeShoot, fldPath *field.Path) field.ErrorList {
+	// the unsupported provider types are reported by the converter
+	provider, err := hyperscaler.Get(shoot.Provider.Type)
+	if err != nil || provider.MaxZones() == 0 {
+		return nil
+	}
+
+	return hyperscaler.ValidateNodesCapacity(shoot, fldPath, provider.MaxZones())
+}
+
+func validateIPFamilies(ipFamilies []gardener.IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(ipFamilies) > 2 {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(ipFamilies), 2))
+	}
+
+	for i, ipFamily := range ipFamilies {
+		if ipFamily != gardener.IPFamilyIPv4 && ipFamily != gardener.IPFamilyIPv6 {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), ipFamily, []gardener.IPFamily{gardener.IPFamilyIPv4, gardener.IPFamilyIPv6}))
+		}
+		if slices.Contains(ipFamilies[:i], ipFamily) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), ipFamily))
+		}
+	}
+
+	return allErrs
+}
+
+func parseCIDR(cidr string, ipFamily gardener.IPFamily) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("must be a valid CIDR: %w", err)
+	}
+
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("must be a network address, use %s", prefix.Masked())
+	}
+
+	if ipFamilyOf(prefix) != ipFamily {
+		return netip.Prefix{}, fmt.Errorf("must belong to the primary IP family %s", ipFamily)
+	}
+
+	return prefix, nil
+}
+
+func ipFamilyOf(prefix netip.Prefix) gardener.IPFamily {
+	if prefix.Addr().Is4() {
+		return gardener.IPFamilyIPv4
+	}
+	return gardener.IPFamilyIPv6
+}
@@ -0,0 +1,211 @@
+package validation
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
+	_ "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/gcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateNetworking(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		networking     imv1.Networking
+		expectedErrors []string
+	}{
+		{
+			name: "IPv4 single-stack when IP families are not set",
+			networking: imv1.Networking{
+				Pods:     "100.64.0.0/12",
+				Nodes:    "10.250.0.0/16",
+				Services: "100.104.0.0/13",
+			},
+		},
+		{
+			name: "IPv6 single-stack",
+			networking: imv1.Networking{
+				Pods:       "fd00:10:64::/56",
+				Nodes:      "2001:db8::/56",
+				Services:   "fd00:10:104::/112",
+				IPFamilies: []gardener.IPFamily{gardener.IPFamilyIPv6},
+			},
+		},
+		{
+			name: "Dual-stack with IPv4 primary family",
+			networking: imv1.Networking{
+				Pods:       "100.64.0.0/12",
+				Nodes:      "10.250.0.0/16",
+				Services:   "100.104.0.0/13",
+				IPFamilies: []gardener.IPFamily{gardener.IPFamilyIPv4, gardener.IPFamilyIPv6},
+			},
+		},
+		{
+			name: "Empty CIDRs are skipped",
+			networking: imv1.Networking{
+				Nodes: "10.250.0.0/22",
+			},
+		},
+		{
+			name: "IPv6 CIDR with IPv4 primary family",
+			networking: imv1.Networking{
+				Nodes:      "2001:db8::/56",
+				IPFamilies: []gardener.IPFamily{gardener.IPFamilyIPv4, gardener.IPFamilyIPv6},
+			},
+			expectedErrors: []string{`spec.shoot.networking.nodes: Invalid value: "2001:db8::/56": must belong to the primary IP family IPv4`},
+		},
+		{
+			name: "IPv6 CIDR when IP families are not set",
+			networking: imv1.Networking{
+				Pods: "fd00:10:64::/56",
+			},
+			expectedErrors: []string{`spec.shoot.networking.pods: Invalid value: "fd00:10:64::/56": must belong to the primary IP family IPv4`},
+		},
+		{
+			name: "Malformed CIDR",
+			networking: imv1.Networking{
+				Services: "100.104.0.0",
+			},
+			expectedErrors: []string{`spec.shoot.networking.services: Invalid value: "100.104.0.0": must be a valid CIDR: netip.ParsePrefix("100.104.0.0"): no '/'`},
+		},
+		{
+			name: "CIDR which is not a network address",
+			networking: imv1.Networking{
+				Nodes: "10.250.1.0/22",
+			},
+			expectedErrors: []string{`spec.shoot.networking.nodes: Invalid value: "10.250.1.0/22": must be a network address, use 10.250.0.0/22`},
+		},
+		{
+			name: "Overlapping CIDRs",
+			networking: imv1.Networking{
+				Pods:     "10.0.0.0/8",
+				Nodes:    "10.250.0.0/16",
+				Services: "10.96.0.0/13",
+			},
+			expectedErrors: []string{
+				`spec.shoot.networking.nodes: Invalid value: "10.250.0.0/16": overlaps with the pods CIDR 10.0.0.0/8`,
+				`spec.shoot.networking.services: Invalid value: "10.96.0.0/13": overlaps with the pods CIDR 10.0.0.0/8`,
+			},
+		},
+		{
+			name: "Duplicated IP family",
+			networking: imv1.Networking{
+				IPFamilies: []gardener.IPFamily{gardener.IPFamilyIPv6, gardener.IPFamilyIPv6},
+			},
+			expectedErrors: []string{`spec.shoot.networking.ipFamilies[1]: Duplicate value: "IPv6"`},
+		},
+		{
+			name: "Unsupported IP family",
+			networking: imv1.Networking{
+				IPFamilies: []gardener.IPFamily{"IPv5"},
+			},
+			expectedErrors: []string{`spec.shoot.networking.ipFamilies[0]: Unsupported value: "IPv5": supported values: "IPv4", "IPv6"`},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// when
+			errs := ValidateNetworking(imv1.RuntimeShoot{Networking: testCase.networking}, field.NewPath("spec", "shoot"))
+
+			// then
+			assert.Equal(t, testCase.expectedErrors, errorStrings(errs))
+		})
+	}
+}
+
+func TestValidateNetworkingNodesCapacity(t *testing.T) {
+	for _, testCase := range []struct {
+		name          string
+		providerType  string
+		ipFamilies    []gardener.IPFamily
+		nodes         string
+		zones         []string
+		infraConfig   *runtime.RawExtension
+		expectedError string
+	}{
+		{
+			name:         "AWS nodes CIDR large enough for three zones",
+			providerType: hyperscaler.TypeAWS,
+			nodes:        "10.250.0.0/22",
+			zones:        []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"},
+		},
+		{
+			name:          "AWS nodes CIDR too small",
+			providerType:  hyperscaler.TypeAWS,
+			nodes:         "10.250.0.0/25",
+			zones:         []string{"eu-central-1a"},
+			expectedError: `spec.shoot.networking.nodes: Invalid value: "10.250.0.0/25": nodes CIDR 10.250.0.0/25 is too small for the zone subnets: the smallest subnet would be /29, at most /28 is allowed, use a /24 or larger CIDR`,
+		},
+		{
+			name:          "Azure IPv6 nodes CIDR too small",
+			providerType:  hyperscaler.TypeAzure,
+			ipFamilies:    []gardener.IPFamily{gardener.IPFamilyIPv6},
+			nodes:         "2001:db8::/61",
+			zones:         []string{"1"},
+			expectedError: `spec.shoot.networking.nodes: Invalid value: "2001:db8::/61": nodes CIDR 2001:db8::/61 is too small for the zone subnets: the smallest subnet would be /65, at most /64 is allowed, use a /60 or larger CIDR`,
+		},
+		{
+			name:          "Too many AWS zones",
+			providerType:  hyperscaler.TypeAWS,
+			nodes:         "10.250.0.0/16",
+			zones:         []string{"z1", "z2", "z3", "z4", "z5"},
+			expectedError: `spec.shoot.networking.nodes: Invalid value: "10.250.0.0/16": nodes CIDR 10.250.0.0/16 cannot be split into 5 zones, at most 4 zones are supported`,
+		},
+		{
+			name:          "Too many Azure zones",
+			providerType:  hyperscaler.TypeAzure,
+			nodes:         "10.250.0.0/16",
+			zones:         []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"},
+			expectedError: `spec.shoot.networking.nodes: Invalid value: "10.250.0.0/16": nodes CIDR 10.250.0.0/16 cannot be split into 9 zones, at most 8 zones are supported`,
+		},
+		{
+			name:         "Skipped when infrastructureConfig is specified",
+			providerType: hyperscaler.TypeAWS,
+			nodes:        "10.250.0.0/25",
+			zones:        []string{"eu-central-1a"},
+			infraConfig:  &runtime.RawExtension{Raw: []byte(`{}`)},
+		},
+		{
+			name:         "Skipped for providers not carving zone subnets",
+			providerType: hyperscaler.TypeGCP,
+			nodes:        "10.250.0.0/25",
+			zones:        []string{"europe-west3-a"},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			shoot := imv1.RuntimeShoot{
+				Networking: imv1.Networking{IPFamilies: testCase.ipFamilies, Nodes: testCase.nodes},
+				Provider: imv1.Provider{
+					Type:                 testCase.providerType,
+					Workers:              []gardener.Worker{{Name: "main", Zones: testCase.zones}},
+					InfrastructureConfig: testCase.infraConfig,
+				},
+			}
+
+			// when
+			errs := ValidateNetworking(shoot, field.NewPath("spec", "shoot"))
+
+			// then
+			if testCase.expectedError == "" {
+				require.Empty(t, errs)
+			} else {
+				require.EqualError(t, errs.ToAggregate(), testCase.expectedError)
+			}
+		})
+	}
+}
+
+func errorStrings(errs field.ErrorList) []string {
+	var result []string
+	for _, err := range errs {
+		result = append(result, err.Error())
+	}
+	return result
+}
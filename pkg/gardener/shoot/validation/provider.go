@@ -0,0 +1,23 @@
+package validation
+
+import (
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateProviderSettings checks the provider specific settings of the Runtime with all registered providers,
+// so the settings of the providers other than the Runtime one are rejected too.
+func ValidateProviderSettings(shoot imv1.RuntimeShoot, fldPath *field.Path, patch bool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, providerType := range hyperscaler.Types() {
+		provider, err := hyperscaler.Get(providerType)
+		if err != nil {
+			continue
+		}
+		allErrs = append(allErrs, provider.ValidateRuntime(shoot, fldPath, patch)...)
+	}
+
+	return allErrs
+}
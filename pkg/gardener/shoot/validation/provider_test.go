@@ -0,0 +1,212 @@
+package validation
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateProviderSettings(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		providerType   string
+		nodes          string
+		aws            *imv1.AWSProvider
+		azure          *imv1.AzureProvider
+		infraConfig    *runtime.RawExtension
+		patch          bool
+		expectedErrors []string
+	}{
+		{
+			name:         "No references",
+			providerType: hyperscaler.TypeAWS,
+		},
+		{
+			name:         "Existing AWS VPC",
+			providerType: hyperscaler.TypeAWS,
+			nodes:        "10.250.0.0/22",
+			aws:          &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "vpc-0123456789abcdef0", CIDR: "10.250.0.0/16"}},
+		},
+		{
+			name:         "Existing Azure VNet",
+			providerType: hyperscaler.TypeAzure,
+			nodes:        "10.250.0.0/22",
+			azure:        &imv1.AzureProvider{VNet: &imv1.AzureVNet{Name: "vnet", ResourceGroup: "group"}},
+		},
+		{
+			name:           "Invalid AWS VPC ID",
+			providerType:   hyperscaler.TypeAWS,
+			nodes:          "10.250.0.0/22",
+			aws:            &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "my-vpc"}},
+			expectedErrors: []string{`spec.shoot.provider.aws.vpc.id: Invalid value: "my-vpc": must be a VPC ID, for example vpc-0123456789abcdef0`},
+		},
+		{
+			name:           "AWS VPC for Azure runtime",
+			providerType:   hyperscaler.TypeAzure,
+			nodes:          "10.250.0.0/22",
+			aws:            &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "vpc-01234567"}},
+			expectedErrors: []string{`spec.shoot.provider.aws: Forbidden: can be set only for aws runtimes`},
+		},
+		{
+			name:         "Incomplete Azure VNet",
+			providerType: hyperscaler.TypeAzure,
+			nodes:        "10.250.0.0/22",
+			azure:        &imv1.AzureProvider{VNet: &imv1.AzureVNet{}},
+			expectedErrors: []string{
+				`spec.shoot.provider.azure.vnet.name: Required value: must be set to use an existing VNet`,
+				`spec.shoot.provider.azure.vnet.resourceGroup: Required value: must be set to use an existing VNet`,
+			},
+		},
+		{
+			name:           "Reference together with infrastructureConfig",
+			providerType:   hyperscaler.TypeAWS,
+			nodes:          "10.250.0.0/22",
+			aws:            &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "vpc-01234567"}},
+			infraConfig:    &runtime.RawExtension{Raw: []byte(`{}`)},
+			expectedErrors: []string{`spec.shoot.provider.aws.vpc: Forbidden: cannot be set together with the infrastructureConfig`},
+		},
+		{
+			name:           "Missing nodes CIDR",
+			providerType:   hyperscaler.TypeAWS,
+			aws:            &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "vpc-01234567"}},
+			expectedErrors: []string{`spec.shoot.networking.nodes: Required value: must be set to create the zone subnets in the existing network`},
+		},
+		{
+			name:           "Nodes CIDR too small for the zone subnets",
+			providerType:   hyperscaler.TypeAzure,
+			nodes:          "10.250.0.0/25",
+			azure:          &imv1.AzureProvider{VNet: &imv1.AzureVNet{Name: "vnet", ResourceGroup: "group"}},
+			expectedErrors: []string{`spec.shoot.networking.nodes: Invalid value: "10.250.0.0/25": nodes CIDR 10.250.0.0/25 is too small for the zone subnets: the smallest subnet would be /29, at most /28 is allowed, use a /24 or larger CIDR`},
+		},
+		{
+			name:           "Nodes CIDR outside the existing network",
+			providerType:   hyperscaler.TypeAWS,
+			nodes:          "10.250.0.0/22",
+			aws:            &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "vpc-01234567", CIDR: "10.251.0.0/16"}},
+			expectedErrors: []string{`spec.shoot.networking.nodes: Invalid value: "10.250.0.0/22": must be inside the CIDR 10.251.0.0/16 of the existing network`},
+		},
+		{
+			name:         "References not validated for existing shoots",
+			providerType: hyperscaler.TypeAWS,
+			nodes:        "10.250.0.0/25",
+			aws:          &imv1.AWSProvider{VPC: &imv1.AWSVPC{ID: "my-vpc"}},
+			patch:        true,
+		},
+		{
+			name:           "Invalid CIDR of the existing network",
+			providerType:   hyperscaler.TypeAzure,
+			nodes:          "10.250.0.0/22",
+			azure:          &imv1.AzureProvider{VNet: &imv1.AzureVNet{Name: "vnet", ResourceGroup: "group", CIDR: "10.250.0.0"}},
+			expectedErrors: []string{`spec.shoot.provider.azure.vnet.cidr: Invalid value: "10.250.0.0": must be a valid CIDR: netip.ParsePrefix("10.250.0.0"): no '/'`},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			shoot := imv1.RuntimeShoot{
+				Networking: imv1.Networking{Nodes: testCase.nodes},
+				Provider: imv1.Provider{
+					Type:                 testCase.providerType,
+					Workers:              []gardener.Worker{{Name: "main", Zones: []string{"1", "2", "3"}}},
+					InfrastructureConfig: testCase.infraConfig,
+					AWS:                  testCase.aws,
+					Azure:                testCase.azure,
+				},
+			}
+
+			// when
+			errs := ValidateProviderSettings(shoot, field.NewPath("spec", "shoot"), testCase.patch)
+
+			// then
+			assert.Equal(t, testCase.expectedErrors, errorStrings(errs))
+		})
+	}
+}
+
+func TestValidateGCPProviderSettings(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		providerType   string
+		gcp            *imv1.GCPProvider
+		expectedErrors []string
+	}{
+		{
+			name:         "No GCP settings",
+			providerType: hyperscaler.TypeGCP,
+		},
+		{
+			name:         "Valid GCP settings",
+			providerType: hyperscaler.TypeGCP,
+			gcp: &imv1.GCPProvider{
+				CloudNAT: &imv1.GCPCloudNAT{MinPortsPerVM: ptr.To(int32(2048)), EndpointIndependentMapping: true},
+				Internal: "10.251.0.0/24",
+				Worker: &imv1.GCPWorker{
+					ServiceAccount:    &imv1.GCPServiceAccount{Email: "workers@project.iam.gserviceaccount.com", Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"}},
+					LocalSSDInterface: "NVME",
+				},
+			},
+		},
+		{
+			name:           "GCP settings for AWS runtime",
+			providerType:   hyperscaler.TypeAWS,
+			gcp:            &imv1.GCPProvider{Internal: "10.251.0.0/24"},
+			expectedErrors: []string{`spec.shoot.provider.gcp: Forbidden: can be set only for gcp runtimes`},
+		},
+		{
+			name:           "Internal CIDR overlapping with the nodes CIDR",
+			providerType:   hyperscaler.TypeGCP,
+			gcp:            &imv1.GCPProvider{Internal: "10.250.1.0/24"},
+			expectedErrors: []string{`spec.shoot.provider.gcp.internal: Invalid value: "10.250.1.0/24": overlaps with the nodes CIDR 10.250.0.0/22`},
+		},
+		{
+			name:           "Internal CIDR not being a network address",
+			providerType:   hyperscaler.TypeGCP,
+			gcp:            &imv1.GCPProvider{Internal: "10.251.0.1/24"},
+			expectedErrors: []string{`spec.shoot.provider.gcp.internal: Invalid value: "10.251.0.1/24": must be a network address, use 10.251.0.0/24`},
+		},
+		{
+			name:           "Invalid Cloud NAT min ports",
+			providerType:   hyperscaler.TypeGCP,
+			gcp:            &imv1.GCPProvider{CloudNAT: &imv1.GCPCloudNAT{MinPortsPerVM: ptr.To(int32(0))}},
+			expectedErrors: []string{`spec.shoot.provider.gcp.cloudNAT.minPortsPerVM: Invalid value: 0: must be between 1 and 65536`},
+		},
+		{
+			name:         "Invalid worker settings",
+			providerType: hyperscaler.TypeGCP,
+			gcp: &imv1.GCPProvider{
+				Worker: &imv1.GCPWorker{
+					ServiceAccount:    &imv1.GCPServiceAccount{Scopes: []string{"scope", ""}},
+					LocalSSDInterface: "IDE",
+				},
+			},
+			expectedErrors: []string{
+				`spec.shoot.provider.gcp.worker.serviceAccount.email: Required value: must be set when providing service account`,
+				`spec.shoot.provider.gcp.worker.serviceAccount.scopes[1]: Required value: must not be empty`,
+				`spec.shoot.provider.gcp.worker.localSSDInterface: Unsupported value: "IDE": supported values: "NVME", "SCSI"`,
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			shoot := imv1.RuntimeShoot{
+				Networking: imv1.Networking{Pods: "10.96.0.0/13", Nodes: "10.250.0.0/22", Services: "10.104.0.0/13"},
+				Provider: imv1.Provider{
+					Type:    testCase.providerType,
+					Workers: []gardener.Worker{{Name: "main", Zones: []string{"europe-west3-a"}}},
+					GCP:     testCase.gcp,
+				},
+			}
+
+			// when
+			errs := ValidateProviderSettings(shoot, field.NewPath("spec", "shoot"), false)
+
+			// then
+			assert.Equal(t, testCase.expectedErrors, errorStrings(errs))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package shoot
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+// Verify compares the shoot converted from a Runtime with the original shoot.
+// Like the shoot-comparator used by the migrator, only the fields set by the converter are compared,
+// the error lists all the differences.
+func Verify(original, converted gardener.Shoot) error {
+	var diffs []string
+
+	compare := func(path string, expected, actual any) {
+		if !equality.Semantic.DeepEqual(expected, actual) {
+			diffs = append(diffs, fmt.Sprintf("%s: expected %s, got %s", path, toJSON(expected), toJSON(actual)))
+		}
+	}
+
+	compare("metadata/name", original.Name, converted.Name)
+	compare("spec/purpose", original.Spec.Purpose, converted.Spec.Purpose)
+	compare("spec/region", original.Spec.Region, converted.Spec.Region)
+	compare("spec/secretBindingName", original.Spec.SecretBindingName, converted.Spec.SecretBindingName)
+	compare("spec/kubernetes/version", original.Spec.Kubernetes.Version, converted.Spec.Kubernetes.Version)
+	compare("spec/kubernetes/kubeAPIServer/oidcConfig", comparableOidcConfig(original), comparableOidcConfig(converted))
+	compare("spec/kubernetes/kubeAPIServer/admissionPlugins", kubeAPIServerOf(original).AdmissionPlugins, kubeAPIServerOf(converted).AdmissionPlugins)
+	compare("spec/kubernetes/kubeAPIServer/requests", kubeAPIServerOf(original).Requests, kubeAPIServerOf(converted).Requests)
+	compare("spec/kubernetes/kubeAPIServer/eventTTL", kubeAPIServerOf(original).EventTTL, kubeAPIServerOf(converted).EventTTL)
+	compare("spec/kubernetes/kubeAPIServer/enableAnonymousAuthentication", kubeAPIServerOf(original).EnableAnonymousAuthentication, kubeAPIServerOf(converted).EnableAnonymousAuthentication)
+	compare("spec/kubernetes/clusterAutoscaler", getClusterAutoscaler(original), getClusterAutoscaler(converted))
+	compare("spec/kubernetes/verticalPodAutoscaler", getVerticalPodAutoscaler(original), getVerticalPodAutoscaler(converted))
+	compare("spec/kubernetes/kubeProxy", getKubeProxy(original), getKubeProxy(converted))
+	compare("spec/networking", comparableNetworking(original), comparableNetworking(converted))
+	compare("spec/controlPlane", getControlPlane(original), getControlPlane(converted))
+	compare("spec/hibernation", original.Spec.Hibernation, converted.Spec.Hibernation)
+	compare("spec/maintenance/autoUpdate", maintenanceOf(original).AutoUpdate, maintenanceOf(converted).AutoUpdate)
+	compare("spec/maintenance/timeWindow", comparableTimeWindow(original), comparableTimeWindow(converted))
+	compare("spec/provider/type", original.Spec.Provider.Type, converted.Spec.Provider.Type)
+	compare("spec/provider/workers", FilterOutFields(original.Spec.Provider.Workers), FilterOutFields(converted.Spec.Provider.Workers))
+	compare("spec/provider/infrastructureConfig", decodeRaw(original.Spec.Provider.InfrastructureConfig), decodeRaw(converted.Spec.Provider.InfrastructureConfig))
+	compare("spec/provider/controlPlaneConfig", decodeRaw(original.Spec.Provider.ControlPlaneConfig), decodeRaw(converted.Spec.Provider.ControlPlaneConfig))
+	compare("spec/extensions/shoot-networking-filter", isNetworkFilterEnabled(original), isNetworkFilterEnabled(converted))
+	compare("spec/extensions/acl", comparableACLRule(original), comparableACLRule(converted))
+
+	if len(diffs) > 0 {
+		return fmt.Errorf("converted shoot differs from the original: %s", strings.Join(diffs, "; "))
+	}
+
+	return nil
+}
+
+// fields not set by the OIDC extender (e.g. ClientAuthentication) are ignored
+func comparableOidcConfig(shoot gardener.Shoot) gardener.OIDCConfig {
+	oidcConfig := getOidcConfig(shoot)
+	oidcConfig.ClientAuthentication = nil
+	return oidcConfig
+}
+
+func kubeAPIServerOf(shoot gardener.Shoot) gardener.KubeAPIServerConfig {
+	return ptr.Deref(shoot.Spec.Kubernetes.KubeAPIServer, gardener.KubeAPIServerConfig{})
+}
+
+func maintenanceOf(shoot gardener.Shoot) gardener.Maintenance {
+	return ptr.Deref(shoot.Spec.Maintenance, gardener.Maintenance{})
+}
+
+// the time windows are compared in UTC, so the same time window set with another offset does not differ
+func comparableTimeWindow(shoot gardener.Shoot) any {
+	timeWindow := maintenanceOf(shoot).TimeWindow
+	if timeWindow == nil {
+		return nil
+	}
+
+	parsed, err := timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End)
+	if err != nil {
+		return timeWindow
+	}
+	return parsed.String()
+}
+
+func comparableNetworking(shoot gardener.Shoot) gardener.Networking {
+	if shoot.Spec.Networking == nil {
+		return gardener.Networking{}
+	}
+
+	return gardener.Networking{
+		Type:       shoot.Spec.Networking.Type,
+		Pods:       shoot.Spec.Networking.Pods,
+		Nodes:      shoot.Spec.Networking.Nodes,
+		Services:   shoot.Spec.Networking.Services,
+		IPFamilies: shoot.Spec.Networking.IPFamilies,
+	}
+}
+
+// the converter appends the KCP egress CIDRs to the allowed ones, so the CIDRs are compared regardless of their order
+func comparableACLRule(shoot gardener.Shoot) *extensions.ACLRule {
+	rule := getACLRule(shoot)
+	if rule == nil {
+		return nil
+	}
+
+	cidrs := slices.Clone(rule.Cidrs)
+	slices.Sort(cidrs)
+
+	return &extensions.ACLRule{
+		Action: rule.Action,
+		Type:   rule.Type,
+		Cidrs:  slices.Compact(cidrs),
+	}
+}
+
+// provider configs are compared after decoding, so the formatting of the raw JSON does not matter
+func decodeRaw(raw *runtime.RawExtension) any {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil
+	}
+
+	var decoded any
+	if err := json.Unmarshal(raw.Raw, &decoded); err != nil {
+		return string(raw.Raw)
+	}
+	return decoded
+}
+
+func toJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
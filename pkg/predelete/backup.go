@@ -0,0 +1,105 @@
+package predelete
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	BackupHookName = "backup"
+
+	BackupLabel              = "infrastructuremanager.kyma-project.io/backup"
+	backupClusterRoleBinding = "clusterrolebindings.json"
+	backupOpenIDConnect      = "openidconnects.json"
+)
+
+var openIDConnectListGVK = schema.GroupVersionKind{ //nolint:gochecknoglobals
+	Group:   "authentication.gardener.cloud",
+	Version: "v1alpha1",
+	Kind:    "OpenIDConnectList",
+}
+
+// BackupHook stores the cluster-admin ClusterRoleBindings and the OpenIDConnect resources of the runtime
+// in a Secret on the KCP cluster, so they can be restored if the runtime has to be recreated
+type BackupHook struct {
+	kcpClient client.Client
+}
+
+func NewBackupHook(kcpClient client.Client) BackupHook {
+	return BackupHook{kcpClient: kcpClient}
+}
+
+func (h BackupHook) Name() string {
+	return BackupHookName
+}
+
+func (h BackupHook) Run(ctx context.Context, runtime imv1.Runtime, shootClient client.Client) (bool, error) {
+	var crbList rbacv1.ClusterRoleBindingList
+	if err := shootClient.List(ctx, &crbList); err != nil {
+		return false, fmt.Errorf("failed to list cluster role bindings: %w", err)
+	}
+
+	var crbs []rbacv1.ClusterRoleBinding
+	for _, crb := range crbList.Items {
+		if crb.RoleRef.Kind == "ClusterRole" && crb.RoleRef.Name == "cluster-admin" {
+			crbs = append(crbs, crb)
+		}
+	}
+
+	oidcList := unstructured.UnstructuredList{}
+	oidcList.SetGroupVersionKind(openIDConnectListGVK)
+	// OpenIDConnect CRD is missing when the OIDC extension is disabled
+	if err := shootClient.List(ctx, &oidcList); err != nil && !meta.IsNoMatchError(err) {
+		return false, fmt.Errorf("failed to list OpenIDConnect resources: %w", err)
+	}
+
+	crbData, err := json.Marshal(crbs)
+	if err != nil {
+		return false, err
+	}
+
+	oidcData, err := json.Marshal(oidcList.Items)
+	if err != nil {
+		return false, err
+	}
+
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackupSecretName(runtime),
+			Namespace: runtime.Namespace,
+		},
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, h.kcpClient, &secret, func() error {
+		secret.Labels = map[string]string{
+			BackupLabel:             "true",
+			imv1.LabelKymaRuntimeID: runtime.Labels[imv1.LabelKymaRuntimeID],
+			imv1.LabelKymaShootName: runtime.Spec.Shoot.Name,
+		}
+		secret.Data = map[string][]byte{
+			backupClusterRoleBinding: crbData,
+			backupOpenIDConnect:      oidcData,
+		}
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to store backup: %w", err)
+	}
+
+	return true, nil
+}
+
+func BackupSecretName(runtime imv1.Runtime) string {
+	return fmt.Sprintf("backup-%s", runtime.Labels[imv1.LabelKymaRuntimeID])
+}
@@ -0,0 +1,77 @@
+package predelete
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Hook is executed before the shoot of a Runtime is deleted.
+// Hooks are called on every reconciliation until all of them complete, so they must be idempotent.
+type Hook interface {
+	Name() string
+	// Run returns true when the hook is completed, false when it must be called again later (e.g. waiting for an acknowledgment)
+	Run(ctx context.Context, runtime imv1.Runtime, shootClient client.Client) (bool, error)
+}
+
+type Hooks []Hook
+
+// Factories of the built-in hooks, the hooks are enabled by name with the pre-delete-hooks flag
+type Factory func(cfg Config) (Hook, error)
+
+type Config struct {
+	KcpClient  client.Client
+	WebhookURL string
+}
+
+var factories = map[string]Factory{ //nolint:gochecknoglobals
+	BackupHookName: func(cfg Config) (Hook, error) {
+		return NewBackupHook(cfg.KcpClient), nil
+	},
+	LoadBalancerCleanupHookName: func(_ Config) (Hook, error) {
+		return NewLoadBalancerCleanupHook(), nil
+	},
+	WebhookHookName: func(cfg Config) (Hook, error) {
+		if cfg.WebhookURL == "" {
+			return nil, fmt.Errorf("webhook URL must be set for the %s hook", WebhookHookName)
+		}
+		return NewWebhookHook(cfg.WebhookURL, nil), nil
+	},
+}
+
+// NewHooks creates the hooks from a comma separated list of names; hooks are run in the given order
+func NewHooks(names string, cfg Config) (Hooks, error) {
+	var hooks Hooks
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		factory, found := factories[name]
+		if !found {
+			return nil, fmt.Errorf("unknown pre-delete hook %s, supported hooks: %s", name, strings.Join(supportedHooks(), ", "))
+		}
+
+		hook, err := factory(cfg)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, hook)
+	}
+
+	return hooks, nil
+}
+
+func supportedHooks() []string {
+	var names []string
+	for name := range factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,232 @@
+package predelete
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewHooks(t *testing.T) {
+	t.Run("Should create hooks in the given order", func(t *testing.T) {
+		// when
+		hooks, err := NewHooks("webhook, loadbalancer-cleanup,backup", Config{WebhookURL: "http://localhost"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, hooks, 3)
+		assert.Equal(t, WebhookHookName, hooks[0].Name())
+		assert.Equal(t, LoadBalancerCleanupHookName, hooks[1].Name())
+		assert.Equal(t, BackupHookName, hooks[2].Name())
+	})
+
+	t.Run("Should return no hooks for empty list", func(t *testing.T) {
+		// when
+		hooks, err := NewHooks("", Config{})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, hooks)
+	})
+
+	t.Run("Should return error for unknown hook", func(t *testing.T) {
+		// when
+		_, err := NewHooks("backup,unknown", Config{})
+
+		// then
+		require.ErrorContains(t, err, "unknown pre-delete hook unknown")
+	})
+
+	t.Run("Should return error for webhook hook without URL", func(t *testing.T) {
+		// when
+		_, err := NewHooks("webhook", Config{})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestLoadBalancerCleanupHook(t *testing.T) {
+	t.Run("Should delete load balancer services and wait until they are removed", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		shootClient := newFakeClient(
+			fixService("lb", corev1.ServiceTypeLoadBalancer),
+			fixService("internal", corev1.ServiceTypeClusterIP),
+		)
+		hook := NewLoadBalancerCleanupHook()
+
+		// when
+		done, err := hook.Run(ctx, fixRuntime(), shootClient)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, done)
+
+		var services corev1.ServiceList
+		require.NoError(t, shootClient.List(ctx, &services))
+		require.Len(t, services.Items, 1)
+		assert.Equal(t, "internal", services.Items[0].Name)
+
+		// when
+		done, err = hook.Run(ctx, fixRuntime(), shootClient)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, done)
+	})
+}
+
+func TestWebhookHook(t *testing.T) {
+	for _, testCase := range []struct {
+		name          string
+		status        int
+		expectedDone  bool
+		expectedError bool
+	}{
+		{name: "Should complete when webhook acknowledges the deletion", status: http.StatusOK, expectedDone: true},
+		{name: "Should wait when webhook is still processing", status: http.StatusAccepted, expectedDone: false},
+		{name: "Should fail when webhook returns error", status: http.StatusInternalServerError, expectedError: true},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			var received WebhookRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&received)
+				w.WriteHeader(testCase.status)
+			}))
+			defer server.Close()
+
+			// when
+			done, err := NewWebhookHook(server.URL, server.Client()).Run(context.Background(), fixRuntime(), nil)
+
+			// then
+			if testCase.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.expectedDone, done)
+			assert.Equal(t, WebhookRequest{
+				RuntimeID: "runtime-id",
+				Name:      "runtime",
+				Namespace: "kcp-system",
+				ShootName: "shoot",
+			}, received)
+		})
+	}
+
+	t.Run("Should fail when webhook does not respond in time", func(t *testing.T) {
+		// given
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		hook := NewWebhookHook(server.URL, server.Client())
+		hook.requestTimeout = 10 * time.Millisecond
+
+		// when
+		done, err := hook.Run(context.Background(), fixRuntime(), nil)
+
+		// then
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.False(t, done)
+	})
+}
+
+func TestBackupHook(t *testing.T) {
+	t.Run("Should store cluster-admin bindings in a secret", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		shootClient := newFakeClient(
+			fixClusterRoleBinding("admin", "cluster-admin"),
+			fixClusterRoleBinding("viewer", "view"),
+		)
+		kcpClient := newFakeClient()
+
+		// when
+		done, err := NewBackupHook(kcpClient).Run(ctx, fixRuntime(), shootClient)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, done)
+
+		var secret corev1.Secret
+		err = kcpClient.Get(ctx, types.NamespacedName{Name: "backup-runtime-id", Namespace: "kcp-system"}, &secret)
+		require.NoError(t, err)
+		assert.Equal(t, "true", secret.Labels[BackupLabel])
+
+		var crbs []rbacv1.ClusterRoleBinding
+		require.NoError(t, json.Unmarshal(secret.Data[backupClusterRoleBinding], &crbs))
+		require.Len(t, crbs, 1)
+		assert.Equal(t, "admin", crbs[0].Name)
+	})
+}
+
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+}
+
+func fixService(name string, serviceType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+		},
+	}
+}
+
+func fixClusterRoleBinding(name, roleName string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		RoleRef: rbacv1.RoleRef{
+			Kind: "ClusterRole",
+			Name: roleName,
+		},
+	}
+}
+
+func fixRuntime() imv1.Runtime {
+	return imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime",
+			Namespace: "kcp-system",
+			Labels: map[string]string{
+				imv1.LabelKymaRuntimeID: "runtime-id",
+			},
+		},
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name: "shoot",
+			},
+		},
+	}
+}
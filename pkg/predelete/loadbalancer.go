@@ -0,0 +1,50 @@
+package predelete
+
+import (
+	"context"
+	"fmt"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const LoadBalancerCleanupHookName = "loadbalancer-cleanup"
+
+// LoadBalancerCleanupHook deletes the services of LoadBalancer type created by the customer,
+// so the cloud load balancers are released before the infrastructure is deleted
+type LoadBalancerCleanupHook struct{}
+
+func NewLoadBalancerCleanupHook() LoadBalancerCleanupHook {
+	return LoadBalancerCleanupHook{}
+}
+
+func (h LoadBalancerCleanupHook) Name() string {
+	return LoadBalancerCleanupHookName
+}
+
+func (h LoadBalancerCleanupHook) Run(ctx context.Context, _ imv1.Runtime, shootClient client.Client) (bool, error) {
+	var services corev1.ServiceList
+	if err := shootClient.List(ctx, &services); err != nil {
+		return false, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	remaining := 0
+	for _, svc := range services.Items {
+		if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+		remaining++
+
+		if !svc.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if err := shootClient.Delete(ctx, &svc); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete service %s/%s: %w", svc.Namespace, svc.Name, err)
+		}
+	}
+
+	// services are removed after the cloud provider released the load balancers
+	return remaining == 0, nil
+}
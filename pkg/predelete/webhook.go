@@ -0,0 +1,88 @@
+package predelete
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	WebhookHookName = "webhook"
+	// WebhookRequestTimeout limits a single webhook call, the hook is retried with the next reconciliation
+	WebhookRequestTimeout = 10 * time.Second
+)
+
+type WebhookRequest struct {
+	RuntimeID string `json:"runtimeID"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	ShootName string `json:"shootName"`
+}
+
+// WebhookHook notifies an external service about the upcoming deletion and waits for the acknowledgment.
+// The service responds with 200 (or 204) when the deletion can proceed, and with 202 when it is still processing.
+type WebhookHook struct {
+	url            string
+	httpClient     *http.Client
+	requestTimeout time.Duration
+}
+
+func NewWebhookHook(url string, httpClient *http.Client) WebhookHook {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return WebhookHook{
+		url:            url,
+		httpClient:     httpClient,
+		requestTimeout: WebhookRequestTimeout,
+	}
+}
+
+func (h WebhookHook) Name() string {
+	return WebhookHookName
+}
+
+func (h WebhookHook) Run(ctx context.Context, runtime imv1.Runtime, _ client.Client) (bool, error) {
+	body, err := json.Marshal(WebhookRequest{
+		RuntimeID: runtime.Labels[imv1.LabelKymaRuntimeID],
+		Name:      runtime.Name,
+		Namespace: runtime.Namespace,
+		ShootName: runtime.Spec.Shoot.Name,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(requestCtx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := h.httpClient.Do(request)
+	if err != nil {
+		return false, fmt.Errorf("failed to call pre-delete webhook: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return true, nil
+	case http.StatusAccepted:
+		return false, nil
+	default:
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return false, fmt.Errorf("pre-delete webhook responded with status %d: %s", response.StatusCode, string(message))
+	}
+}
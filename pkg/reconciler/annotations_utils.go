@@ -1,8 +1,14 @@
 package reconciler
 
 const (
-	ForceReconcileAnnotation   = "operator.kyma-project.io/force-patch-reconciliation"
-	SuspendReconcileAnnotation = "operator.kyma-project.io/suspend-patch-reconciliation"
+	ForceReconcileAnnotation     = "operator.kyma-project.io/force-patch-reconciliation"
+	SuspendReconcileAnnotation   = "operator.kyma-project.io/suspend-patch-reconciliation"
+	SkipPreDeleteHooksAnnotation = "operator.kyma-project.io/skip-pre-delete-hooks"
+	DeletionProtectionAnnotation = "operator.kyma-project.io/deletion-protection"
+	DeletionPolicyAnnotation     = "operator.kyma-project.io/deletion-policy"
+	AdoptShootAnnotation         = "operator.kyma-project.io/adopt-shoot"
+
+	DeletionPolicyOrphan = "orphan"
 )
 
 func ShouldSuspendReconciliation(annotations map[string]string) bool {
@@ -20,3 +26,35 @@ func ShouldForceReconciliation(annotations map[string]string) bool {
 	}
 	return false
 }
+
+func ShouldSkipPreDeleteHooks(annotations map[string]string) bool {
+	skipHooks, found := annotations[SkipPreDeleteHooksAnnotation]
+	if found && skipHooks == "true" {
+		return true
+	}
+	return false
+}
+
+func IsDeletionProtected(annotations map[string]string) bool {
+	protected, found := annotations[DeletionProtectionAnnotation]
+	if found && protected == "true" {
+		return true
+	}
+	return false
+}
+
+func ShouldOrphanShoot(annotations map[string]string) bool {
+	policy, found := annotations[DeletionPolicyAnnotation]
+	if found && policy == DeletionPolicyOrphan {
+		return true
+	}
+	return false
+}
+
+func ShouldAdoptShoot(annotations map[string]string) bool {
+	adopt, found := annotations[AdoptShootAnnotation]
+	if found && adopt == "true" {
+		return true
+	}
+	return false
+}
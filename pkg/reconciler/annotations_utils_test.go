@@ -72,3 +72,133 @@ func TestShouldSuspendReconciliation(t *testing.T) {
 		})
 	}
 }
+
+func TestShouldSkipPreDeleteHooks(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should skip pre-delete hooks for `operator.kyma-project.io/skip-pre-delete-hooks` set to `true",
+			annotations:    map[string]string{"operator.kyma-project.io/skip-pre-delete-hooks": "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not skip pre-delete hooks for `operator.kyma-project.io/skip-pre-delete-hooks` set to `kaloryfer",
+			annotations:    map[string]string{"operator.kyma-project.io/skip-pre-delete-hooks": "kaloryfer"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should not skip pre-delete hooks for nil annotations",
+			annotations:    nil,
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+
+			// when
+			skipHooks := ShouldSkipPreDeleteHooks(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, skipHooks)
+		})
+	}
+}
+
+func TestIsDeletionProtected(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should protect from deletion for `operator.kyma-project.io/deletion-protection` set to `true",
+			annotations:    map[string]string{"operator.kyma-project.io/deletion-protection": "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not protect from deletion for `operator.kyma-project.io/deletion-protection` set to `false",
+			annotations:    map[string]string{"operator.kyma-project.io/deletion-protection": "false"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should not protect from deletion for nil annotations",
+			annotations:    nil,
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// when
+			protected := IsDeletionProtected(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, protected)
+		})
+	}
+}
+
+func TestShouldOrphanShoot(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should orphan shoot for `operator.kyma-project.io/deletion-policy` set to `orphan",
+			annotations:    map[string]string{"operator.kyma-project.io/deletion-policy": "orphan"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not orphan shoot for `operator.kyma-project.io/deletion-policy` set to `delete",
+			annotations:    map[string]string{"operator.kyma-project.io/deletion-policy": "delete"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should not orphan shoot for nil annotations",
+			annotations:    nil,
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// when
+			orphan := ShouldOrphanShoot(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, orphan)
+		})
+	}
+}
+
+func TestShouldAdoptShoot(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should adopt shoot for `operator.kyma-project.io/adopt-shoot` set to `true",
+			annotations:    map[string]string{"operator.kyma-project.io/adopt-shoot": "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not adopt shoot for `operator.kyma-project.io/adopt-shoot` set to `false",
+			annotations:    map[string]string{"operator.kyma-project.io/adopt-shoot": "false"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should not adopt shoot for nil annotations",
+			annotations:    nil,
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// when
+			adopt := ShouldAdoptShoot(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, adopt)
+		})
+	}
+}